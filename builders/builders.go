@@ -23,8 +23,8 @@ type ReturningWhereBuilder interface {
 }
 
 type ReturningBuilder interface {
-	Builder
+	ExecutableBuilder
 
 	// Returning sets what columns to return
-	Returning(column string, moreColumns ...string) Builder
+	Returning(column string, moreColumns ...string) ExecutableBuilder
 }
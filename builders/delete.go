@@ -1,6 +1,9 @@
 package builders
 
-import incondition "github.com/williabk198/jagsqlb/internal/condition"
+import (
+	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	injoin "github.com/williabk198/jagsqlb/internal/join"
+)
 
 // DeleteBuilder defines the functions needed to build an SQL "DELETE" statement.
 type DeleteBuilder interface {
@@ -10,6 +13,24 @@ type DeleteBuilder interface {
 	// This is fundamentally equivalent to using "FROM" in an "SELECT" statement.
 	Using(table string) DeleteBuilder
 
+	// From defines a table that the rows will be deleted from. When used, the table given to the Delete Builder is only
+	// the target of the deletion, and can be an alias of a table defined here. This is only supported by dialects that
+	// support `dialect.ClauseDeleteJoin`, such as MySQL.
+	//
+	// For example:
+	//
+	//	sqlBuilder.Delete("o").From("orders o").Join(join.TypeInner, "customers c", join.On(
+	//	    condition.Equals("o.customer_id", condition.ColumnValue("c.id")),
+	//	)).Where(condition.Equals("c.active", false))
+	//
+	// Will result in "DELETE `o` FROM `orders` AS `o` INNER JOIN `customers` AS `c` ON `o`.`customer_id` = `c`.`id` WHERE `c`.`active` = ?;"
+	From(table string) DeleteBuilder
+
+	// Join joins another table onto the table that rows are being deleted from, so that its columns can be used within the
+	// "WHERE" clause. If `From` wasn't used, then the table given to the Delete Builder is both the target of the deletion
+	// and the table that is joined onto. This is only supported by dialects that support `dialect.ClauseDeleteJoin`, such as MySQL.
+	Join(joinType injoin.Type, table string, joinRelation injoin.Relation) DeleteBuilder
+
 	// Where sets the conditions for which items will be deleted from the database.
 	// If `moreConditions` is not nil, the provided conditions will be concatenated with "AND" when built.
 	Where(condition incondition.Condition, moreConditions ...incondition.Condition) ReturningWhereBuilder
@@ -0,0 +1,44 @@
+package builders
+
+import (
+	"context"
+	"database/sql"
+)
+
+// QueryerContext is implemented by anything that can run a query that returns rows, such as `*sql.DB`, `*sql.Tx` and `*sql.Conn`
+type QueryerContext interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// ExecerContext is implemented by anything that can run a query without returning rows, such as `*sql.DB`, `*sql.Tx` and `*sql.Conn`
+type ExecerContext interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Row is the result of a query that is expected to return at most one row
+type Row interface {
+	// Scan copies the columns of the row into the values pointed at by `dest`. If the query didn't return any rows,
+	// then an error wrapping `sql.ErrNoRows` is returned.
+	Scan(dest ...any) error
+	// Err returns any error that was encountered while building or running the query
+	Err() error
+}
+
+// Executor runs the query of a Builder against a database
+type Executor interface {
+	// Exec builds the query and runs it using `db` without returning any rows.
+	// If the query fails to run, then the returned error will include the query that was built.
+	Exec(ctx context.Context, db ExecerContext) (sql.Result, error)
+	// Query builds the query and runs it using `db`, returning the rows of the result set.
+	// If the query fails to run, then the returned error will include the query that was built.
+	Query(ctx context.Context, db QueryerContext) (*sql.Rows, error)
+	// QueryRow builds the query and runs it using `db`, returning the first row of the result set.
+	// Any error is deferred until `Row.Scan` is called.
+	QueryRow(ctx context.Context, db QueryerContext) Row
+}
+
+// ExecutableBuilder is a Builder whose query can be run directly against a database
+type ExecutableBuilder interface {
+	Builder
+	Executor
+}
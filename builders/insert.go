@@ -1,7 +1,11 @@
 package builders
 
+import (
+	incondition "github.com/williabk198/jagsqlb/internal/condition"
+)
+
 type InsertBuilder interface {
-	Builder
+	ExecutableBuilder
 	InsertValueBuilder
 
 	// Columns defines the list of columns that will be receiving data in the "INSERT" statement
@@ -23,11 +27,11 @@ type InsertBuilder interface {
 	//    query = `INSERT INTO "table1" ("field1", "Field2") VALUES ($1, $2);`
 	//    params = []any{"hello", 42}
 	//    err = nil
-	Data(data any, moreData ...any) ReturningBuilder
+	Data(data any, moreData ...any) InsertConflictBuilder
 
 	// DefaultValues will instruct to the database to use the default values for each of the columns in the table
 	// instead of providing the values manually.
-	DefaultValues() ReturningBuilder
+	DefaultValues() InsertConflictBuilder
 }
 
 type InsertValueBuilder interface {
@@ -35,5 +39,62 @@ type InsertValueBuilder interface {
 	//
 	// NOTE: the length of `vals` as well as subsequent entries in `moreVals` must equal the number of columns provided.
 	// Meaning, if only two columns were provided, then `vals` and each item in `moreVals` MUST contain exactly two elements.
-	Values(vals []any, moreVals ...[]any) ReturningBuilder
+	Values(vals []any, moreVals ...[]any) InsertConflictBuilder
+
+	// FromSelect inserts the result set of the provided query instead of a list of values.
+	//
+	// For example:
+	//
+	//    query, params, err := jagsqlb.NewSqlBuilder().Insert("archive").Columns("id", "name").FromSelect(
+	//        jagsqlb.NewSqlBuilder().Select("orders", "id", "name").Where(condition.LessThan("placed_at", cutoff)),
+	//    ).Returning("id").Build()
+	//
+	// Results in the following:
+	//
+	//    query = `INSERT INTO "archive" ("id", "name") SELECT "id", "name" FROM "orders" WHERE "placed_at" < $1 RETURNING "id";`
+	//    params = []any{cutoff}
+	//    err = nil
+	FromSelect(query Builder) InsertConflictBuilder
+}
+
+type InsertConflictBuilder interface {
+	ReturningBuilder
+
+	// OnConflict defines what happens when a row being inserted conflicts with an existing row on the provided columns.
+	// If no columns are provided, then any conflict will be handled. The columns are ignored by the MySQL dialect,
+	// which handles conflicts on any unique key.
+	//
+	// For example:
+	//
+	//    query, params, err := jagsqlb.NewSqlBuilder().Insert("stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+	//        OnConflict("sku").DoUpdateSet(map[string]any{"qty": expr.Excluded("qty")}).
+	//        Returning("qty").Build()
+	//
+	// Results in the following:
+	//
+	//    query = `INSERT INTO "stock" ("sku", "qty") VALUES ($1, $2) ON CONFLICT ("sku") DO UPDATE SET "qty" = EXCLUDED."qty" RETURNING "qty";`
+	//    params = []any{"abc", 5}
+	//    err = nil
+	OnConflict(columns ...string) ConflictActionBuilder
+
+	// OnConstraint defines what happens when a row being inserted violates the constraint with the provided name
+	OnConstraint(name string) ConflictActionBuilder
+}
+
+type ConflictActionBuilder interface {
+	// DoNothing skips the insertion of any row that conflicts with an existing row
+	DoNothing() ReturningBuilder
+
+	// DoUpdateSet updates the existing row when a conflict occurs. The provided data can either be a map of column names
+	// to values, or a struct that will be parsed the same way as `UpdateBuilder.SetStruct`. Values can be expressions,
+	// such as `expr.Excluded`, to reference the row that was proposed for insertion.
+	DoUpdateSet(data any) ConflictUpdateBuilder
+}
+
+type ConflictUpdateBuilder interface {
+	ReturningBuilder
+
+	// Where sets the conditions that an existing row must satisfy in order to be updated.
+	// If `moreConditions` is not nil, the provided conditions will be concatenated with "AND" when built.
+	Where(condition incondition.Condition, moreConditions ...incondition.Condition) ReturningWhereBuilder
 }
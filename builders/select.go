@@ -2,14 +2,39 @@ package builders
 
 import (
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
 	injoin "github.com/williabk198/jagsqlb/internal/join"
 	"github.com/williabk198/jagsqlb/types"
 )
 
 type SelectBuilder interface {
 	OrderByPaginationBuilders
+	CompoundBuilders
+	WindowBuilders
+	SeekBuilders
 	// Table adds an addition table to select from as well as any columns that should be returned in the result set.
-	Table(table string, columns ...string) SelectBuilder
+	// The table can either be a string or a subquery created with `jagsqlb.SubQuery`.
+	// Each column can either be a string or an expression from the `expr` package.
+	Table(table any, columns ...any) SelectBuilder
+	// Distinct removes any duplicate rows from the result set. e.g. `SELECT DISTINCT "city" FROM "customers";`
+	// This replaces any columns provided to `DistinctOn`.
+	Distinct() SelectBuilder
+	// DistinctOn keeps only the first row of each set of rows that have the same values for the provided columns, or expressions.
+	// This is only supported by PostgreSQL. If the query is ordered, then the provided columns must be the leftmost columns of
+	// the "ORDER BY" clause, otherwise an error is returned when the query is built.
+	//
+	// For Example:
+	//
+	//    query, _, err := jagsqlb.NewSqlBuilder().Select("orders", "*").DistinctOn("customer_id").OrderBy(
+	//        types.ColumnOrdering{ColumnName: "customer_id", Ordering: types.OrderingAscending},
+	//        types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingDescending},
+	//    ).Build()
+	//
+	// Will result in:
+	//
+	//    query = `SELECT DISTINCT ON ("customer_id") * FROM "orders" ORDER BY "customer_id" ASC, "created_at" DESC;`
+	//    err = nil
+	DistinctOn(column any, moreColumns ...any) SelectBuilder
 	// Join defines a join clause to be used in the "SELECT" query as well as any columns from the joining table to add to the result set.
 	//
 	// For Example:
@@ -25,27 +50,152 @@ type SelectBuilder interface {
 	//
 	//    query = `SELECT "t1"."col1", "t2"."col3", "t2"."col4" FROM "table1" AS "t1" INNER JOIN "table2" AS "t2" ON "t1"."col1" = "t2"."col2";`
 	//    err = nil
-	Join(joinType injoin.Type, table string, joinRelation injoin.Relation, includeColumns ...string) JoinBuilder
+	Join(joinType injoin.Type, table any, joinRelation injoin.Relation, includeColumns ...any) JoinBuilder
 
 	// Where sets the conditions for which items will be selected from the database.
 	// If `moreConditions` is not nil, the provided conditions will be concatenated with "AND" when built.
 	Where(incondition.Condition, ...incondition.Condition) SelectWhereBuilder
+
+	// GroupBy sets what columns, or expressions, the result set will be grouped by
+	GroupBy(column any, moreColumns ...any) GroupByBuilder
 }
 
 type JoinBuilder interface {
 	OrderByPaginationBuilders
+	CompoundBuilders
+	WindowBuilders
+	SeekBuilders
 
 	// Join defines a join clause to be used in the "SELECT" query as well as any columns from the joining table to add to the result set.
-	Join(joinType injoin.Type, table string, joinRelation injoin.Relation, includeColumns ...string) JoinBuilder
+	Join(joinType injoin.Type, table any, joinRelation injoin.Relation, includeColumns ...any) JoinBuilder
 
 	// Where sets the conditions for which items will be selected from the database.
 	// If `moreConditions` is not nil, the provided conditions will be concatenated with "AND" when built.
 	Where(condition incondition.Condition, moreConditions ...incondition.Condition) SelectWhereBuilder
+
+	// GroupBy sets what columns, or expressions, the result set will be grouped by
+	GroupBy(column any, moreColumns ...any) GroupByBuilder
 }
 
 type SelectWhereBuilder interface {
 	OrderByPaginationBuilders
+	CompoundBuilders
+	WindowBuilders
+	SeekBuilders
 	WhereBuilder[SelectWhereBuilder]
+
+	// GroupBy sets what columns, or expressions, the result set will be grouped by
+	GroupBy(column any, moreColumns ...any) GroupByBuilder
+}
+
+type GroupByBuilder interface {
+	OrderByPaginationBuilders
+	CompoundBuilders
+	WindowBuilders
+
+	// Having sets the conditions that each group must satisfy to be included in the result set.
+	// If `moreConditions` is not nil, the provided conditions will be concatenated with "AND" when built.
+	//
+	// For Example:
+	//
+	//    query, params, err := jagsqlb.NewSqlBuilder().Select("orders", "customer_id").GroupBy("customer_id").Having(
+	//        condition.GreaterThan("customer_id", 100),
+	//    ).Build()
+	//
+	// Will result in:
+	//
+	//    query = `SELECT "customer_id" FROM "orders" GROUP BY "customer_id" HAVING "customer_id" > $1;`
+	//    params = []any{100}
+	//    err = nil
+	Having(condition incondition.Condition, moreConditions ...incondition.Condition) HavingBuilder
+}
+
+type HavingBuilder interface {
+	OrderByPaginationBuilders
+	CompoundBuilders
+	WindowBuilders
+	WhereBuilder[HavingBuilder]
+}
+
+type WindowBuilders interface {
+	// Window defines a named window in the "WINDOW" clause of the query, which window functions can then refer to
+	// by using `expr.NamedWindow`.
+	//
+	// For Example:
+	//
+	//    query, _, err := jagsqlb.NewSqlBuilder().Select("employees", "name", expr.Rank().Over(expr.NamedWindow("w")).As("rank")).Window(
+	//        "w", expr.PartitionBy("department").Order(types.ColumnOrdering{ColumnName: "salary", Ordering: types.OrderingDescending}),
+	//    ).Build()
+	//
+	// Will result in:
+	//
+	//    query = `SELECT "name", RANK() OVER "w" AS "rank" FROM "employees" WINDOW "w" AS (PARTITION BY "department" ORDER BY "salary" DESC);`
+	//    err = nil
+	Window(name string, definition inexpr.Window) WindowBuilder
+}
+
+// WindowBuilder represents the "WINDOW" clause of a SELECT statement
+type WindowBuilder interface {
+	OrderByPaginationBuilders
+	CompoundBuilders
+	WindowBuilders
+}
+
+type CompoundBuilders interface {
+	// Union combines the result set of the query with the result set of `query`, removing any duplicate rows.
+	//
+	// For Example:
+	//
+	//    query, params, err := jagsqlb.NewSqlBuilder().Select("customers", "email").Union(
+	//        jagsqlb.NewSqlBuilder().Select("suppliers", "email").Where(condition.Equals("active", true)),
+	//    ).OrderBy(types.ColumnOrdering{ColumnName: "email", Ordering: types.OrderingAscending}).Limit(10).Build()
+	//
+	// Will result in:
+	//
+	//    query = `SELECT "email" FROM "customers" UNION SELECT "email" FROM "suppliers" WHERE "active" = $1 ORDER BY "email" ASC LIMIT 10;`
+	//    params = []any{true}
+	//    err = nil
+	Union(query Builder) CompoundBuilder
+	// UnionAll combines the result set of the query with the result set of `query`, keeping any duplicate rows
+	UnionAll(query Builder) CompoundBuilder
+	// Intersect limits the result set of the query to the rows that are also in the result set of `query`
+	Intersect(query Builder) CompoundBuilder
+	// Except removes any rows in the result set of `query` from the result set of the query
+	Except(query Builder) CompoundBuilder
+}
+
+// CompoundBuilder represents multiple SELECT queries that have been combined with UNION, INTERSECT or EXCEPT.
+// Any ordering or pagination applies to the combined result set rather than the last query.
+type CompoundBuilder interface {
+	OrderByPaginationBuilders
+	CompoundBuilders
+}
+
+type SeekBuilders interface {
+	// SeekAfter limits the result set to the rows that come after the row with the provided values when sorted by `orderings`,
+	// which is also used as the "ORDER BY" clause of the query. This is also known as keyset pagination. Each value in `lastValues`
+	// is the value of the respective ordering in the last row of the previous page. If `lastValues` is empty,
+	// then the first page is returned. The last column of `orderings` should be unique, so that every row has a distinct position.
+	//
+	// For Example:
+	//
+	//    query, params, err := jagsqlb.NewSqlBuilder().Select("posts", "*").Where(condition.Equals("author_id", 7)).SeekAfter(
+	//        []types.ColumnOrdering{
+	//            {ColumnName: "created_at", Ordering: types.OrderingDescending},
+	//            {ColumnName: "id", Ordering: types.OrderingDescending},
+	//        },
+	//        []any{lastCreatedAt, lastID},
+	//    ).Limit(20).Build()
+	//
+	// Will result in:
+	//
+	//    query = `SELECT * FROM "posts" WHERE "author_id" = $1 AND ("created_at", "id") < ($2, $3) ORDER BY "created_at" DESC, "id" DESC LIMIT 20;`
+	//    params = []any{7, lastCreatedAt, lastID}
+	//    err = nil
+	//
+	// When the orderings mix directions, place NULL values explicitly or a value is NULL, then the rows are instead compared
+	// one column at a time. e.g. `("a" > $1 OR ("a" = $2 AND "b" < $3))`
+	SeekAfter(orderings []types.ColumnOrdering, lastValues []any) LimitBuilder
 }
 
 type OrderByPaginationBuilders interface {
@@ -63,7 +213,68 @@ type OffsetBuilder interface {
 }
 
 type LimitBuilder interface {
-	Builder
+	LockableBuilder
 	// Limit sets how many items will be in the result set
-	Limit(uint) Builder
+	Limit(uint) LockableBuilder
+}
+
+type LockableBuilder interface {
+	ExecutableBuilder
+	RowLockBuilders
+	CountBuilders
+}
+
+type CountBuilders interface {
+	// CountQuery returns a query that counts the rows returned by the query, which is useful for finding the total number
+	// of rows when paginating. The tables, joins and conditions of the query are kept, while any ordering, pagination
+	// and row locks are dropped, along with the condition added by `SeekAfter`. If the query has a GROUP BY clause, removes duplicate rows or is a compound query, then it is
+	// wrapped in a subquery so that the rows it returns are counted rather than the rows of each group.
+	//
+	// For Example:
+	//
+	//    query, params, err := jagsqlb.NewSqlBuilder().Select("posts", "*").Where(condition.Equals("author_id", 7)).OrderBy(
+	//        types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingDescending},
+	//    ).Limit(20).CountQuery().Build()
+	//
+	// Will result in:
+	//
+	//    query = `SELECT COUNT(*) FROM "posts" WHERE "author_id" = $1;`
+	//    params = []any{7}
+	//    err = nil
+	CountQuery() ExecutableBuilder
+}
+
+// ToCount returns a query that counts the rows returned by `query`. It is the same as calling `query.CountQuery()`.
+func ToCount(query CountBuilders) ExecutableBuilder {
+	return query.CountQuery()
+}
+
+type RowLockBuilders interface {
+	// ForUpdate locks the selected rows so that they cannot be modified, deleted or locked by another transaction.
+	// If any tables are provided, then only the rows from those tables will be locked. Rows can't be locked by a compound query,
+	// or a query that uses "GROUP BY", "DISTINCT" or window functions, so an error is returned when such a query is built.
+	//
+	// For example:
+	//
+	//	sqlBuilder.Select("jobs", "*").Where(condition.Equals("status", "pending")).Limit(1).ForUpdate().SkipLocked()
+	//
+	// Will result in `SELECT * FROM "jobs" WHERE "status" = $1 LIMIT 1 FOR UPDATE SKIP LOCKED;`
+	ForUpdate(tables ...string) RowLockBuilder
+	// ForNoKeyUpdate is the same as ForUpdate, but does not block other transactions from locking the rows with ForKeyShare
+	ForNoKeyUpdate(tables ...string) RowLockBuilder
+	// ForShare locks the selected rows so that they cannot be modified or deleted by another transaction,
+	// while still allowing other transactions to lock them with ForShare.
+	// If any tables are provided, then only the rows from those tables will be locked.
+	ForShare(tables ...string) RowLockBuilder
+	// ForKeyShare is the same as ForShare, but only blocks other transactions from deleting the rows or changing their keys
+	ForKeyShare(tables ...string) RowLockBuilder
+}
+
+type RowLockBuilder interface {
+	LockableBuilder
+
+	// SkipLocked skips over any rows that can't be locked immediately, rather than waiting for them to be released
+	SkipLocked() LockableBuilder
+	// NoWait makes the query fail if any rows can't be locked immediately, rather than waiting for them to be released
+	NoWait() LockableBuilder
 }
@@ -2,16 +2,50 @@ package builders
 
 import (
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	injoin "github.com/williabk198/jagsqlb/internal/join"
 )
 
 type UpdateBuilder interface {
 	UpdateFromBuilder
+	UpdateSetBuilders
+
+	// SetMap sets each column in `colValMap` to its associated value. The columns are set in sorted order, so that the
+	// resulting query is the same every time it is built.
 	SetMap(colValMap map[string]any) UpdateFromWhereBuilder
 	SetStruct(value any) UpdateFromWhereBuilder
+
+	// Join joins another table onto the table being updated, so that its columns can be used within the "SET" and "WHERE" clauses.
+	// This is only supported by dialects that support `dialect.ClauseUpdateJoin`, such as MySQL. Otherwise, use `From` instead.
+	//
+	// For example:
+	//
+	//	sqlBuilder.Update("orders o").Join(join.TypeInner, "customers c", join.On(
+	//	    condition.Equals("o.customer_id", condition.ColumnValue("c.id")),
+	//	)).Set("o.priority", condition.ColumnValue("c.tier")).Where(condition.Equals("c.active", true))
+	//
+	// Will result in "UPDATE `orders` AS `o` INNER JOIN `customers` AS `c` ON `o`.`customer_id` = `c`.`id` SET `o`.`priority`=`c`.`tier` WHERE `c`.`active` = ?;"
+	Join(joinType injoin.Type, table string, joinRelation injoin.Relation) UpdateBuilder
+}
+
+type UpdateSetBuilders interface {
+	// Set adds a column to be set to the provided value. Columns are set in the order that they were added.
+	// The value can be a ColumnValue, an expression(see the `expr` package), or any other value, which will be parameterized.
+	//
+	// For example:
+	//
+	//	sqlBuilder.Update("products").Set("stock", expr.Col("stock").Minus(1)).Set("updated_at", expr.Now())
+	//
+	// Will result in `UPDATE "products" SET "stock"="stock" - $1, "updated_at"=CURRENT_TIMESTAMP;`
+	Set(column string, value any) UpdateSetBuilder
+}
+
+type UpdateSetBuilder interface {
+	UpdateFromWhereBuilder
+	UpdateSetBuilders
 }
 
 type UpdateFromBuilder interface {
-	Builder
+	ExecutableBuilder
 	From(table string, moreTable ...string) ReturningWhereBuilder
 }
 
@@ -0,0 +1,30 @@
+package builders
+
+type WithBuilder interface {
+	// With adds a common table expression with the given name to the statement that follows. If `columns` are provided,
+	// then they will be used as the column names of the common table expression.
+	//
+	// For Example:
+	//
+	//    recent := jagsqlb.NewSqlBuilder().Select("orders", "*").Where(condition.GreaterThan("placed_at", lastWeek))
+	//    query, params, err := jagsqlb.NewSqlBuilder().With("recent_orders", recent).Select("recent_orders", "*").Build()
+	//
+	// Will result in:
+	//
+	//    query = `WITH "recent_orders" AS (SELECT * FROM "orders" WHERE "placed_at" > $1) SELECT * FROM "recent_orders";`
+	//    params = []any{lastWeek}
+	//    err = nil
+	With(name string, query Builder, columns ...string) WithBuilder
+	// WithRecursive adds a common table expression that is able to reference itself. "WITH RECURSIVE" is rendered
+	// when the dialect requires it.
+	WithRecursive(name string, query Builder, columns ...string) WithBuilder
+
+	// Delete creates a "DELETE" statement that is preceded by the common table expressions
+	Delete(table string) DeleteBuilder
+	// Insert creates an "INSERT" statement that is preceded by the common table expressions
+	Insert(table string) InsertBuilder
+	// Select creates a "SELECT" statement that is preceded by the common table expressions
+	Select(table any, columns ...any) SelectBuilder
+	// Update creates an "UPDATE" statement that is preceded by the common table expressions
+	Update(table string) UpdateBuilder
+}
@@ -1,7 +1,14 @@
 package condition
 
 import (
+	"fmt"
+	"reflect"
+
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
 // ColumnValue is to be used in a condition to represent a value from a table column.
@@ -19,123 +26,162 @@ func ColumnValue(columnName string) incondition.ColumnValue {
 	}
 }
 
-// Equals returns a condition that can be used in building `WHERE` and `JOIN` clauses that equates a column to a value
-func Equals(columnName string, value any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "=",
-		Values:     []any{value},
+// newSimpleCondition creates a SimpleCondition for the provided column. The column can either be a string, which will be parsed as
+// a column reference, or an expression (see the `expr` package).
+func newSimpleCondition(column any, operator string, values []any) incondition.SimpleCondition {
+	sc := incondition.SimpleCondition{
+		Operator: operator,
+		Values:   values,
 	}
+
+	switch col := column.(type) {
+	case string:
+		sc.ColumnName = col
+	case intypes.Ordering:
+		sc.Expression = inexpr.Invalid{Err: intypes.ErrMisplacedOrdering}
+	case intypes.Expression:
+		sc.Expression = col
+	default:
+		sc.Expression = invalidColumn{value: column}
+	}
+
+	return sc
+}
+
+// invalidColumn is used in place of a condition column that is neither a string nor an expression.
+// This defers the error until the condition is parameterized when the query is built.
+type invalidColumn struct {
+	value any
+}
+
+func (ic invalidColumn) Parameterize(dialect.Dialect) (string, []any, error) {
+	return "", nil, fmt.Errorf("invalid condition column type %T; expected a string or an expression", ic.value)
+}
+
+// Equals returns a condition that can be used in building `WHERE` and `JOIN` clauses that equates a column to a value
+func Equals(column any, value any) incondition.Condition {
+	return newSimpleCondition(column, "=", []any{value})
 }
 
 // NotEquals returns a condition that can be used in building `WHERE` and `JOIN` clauses that
 // indicates a column should not be equal to the given value
-func NotEquals(columnName string, value any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "!=",
-		Values:     []any{value},
-	}
+func NotEquals(column any, value any) incondition.Condition {
+	return newSimpleCondition(column, "!=", []any{value})
 }
 
 // GreaterThan returns a condition that can be used in building `WHERE` and `JOIN` clauses that
 // indicates a column should be greater than the given value
-func GreaterThan(columnName string, value any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   ">",
-		Values:     []any{value},
-	}
+func GreaterThan(column any, value any) incondition.Condition {
+	return newSimpleCondition(column, ">", []any{value})
 }
 
 // GreaterThanEqual returns a condition that can be used in building `WHERE` and `JOIN` clauses that
 // indicates a column should be greater than or equal to the given value
-func GreaterThanEqual(columnName string, value any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   ">=",
-		Values:     []any{value},
-	}
+func GreaterThanEqual(column any, value any) incondition.Condition {
+	return newSimpleCondition(column, ">=", []any{value})
 }
 
 // LessThan returns a condition that can be used in building `WHERE` and `JOIN` clauses that
 // indicates a column should be less than the given value
-func LessThan(columnName string, value any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "<",
-		Values:     []any{value},
-	}
+func LessThan(column any, value any) incondition.Condition {
+	return newSimpleCondition(column, "<", []any{value})
 }
 
 // LessThanEqual returns a condition that can be used in building `WHERE` and `JOIN` clauses that
 // indicates a column should be less than or equal to the given value
-func LessThanEqual(columnName string, value any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "<=",
-		Values:     []any{value},
-	}
+func LessThanEqual(column any, value any) incondition.Condition {
+	return newSimpleCondition(column, "<=", []any{value})
 }
 
 // IsNull returns a condition that can be used in building `WHERE` and `JOIN` clauses that
 // indicates a column should be NULL
-func IsNull(columnName string) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "IS",
-		Values:     []any{"NULL"},
-	}
+func IsNull(column any) incondition.Condition {
+	return newSimpleCondition(column, "IS", []any{"NULL"})
 }
 
 // IsNotNull returns a condition that can be used in building `WHERE` and `JOIN` clauses that
 // indicates a column should not be NULL
-func IsNotNull(columnName string) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "IS NOT",
-		Values:     []any{"NULL"},
-	}
+func IsNotNull(column any) incondition.Condition {
+	return newSimpleCondition(column, "IS NOT", []any{"NULL"})
 }
 
 // In returns a condition that can be used in building `WHERE` and `JOIN` clauses that
-// indicates a columns value should be in the provided slice of values
-func In(columnName string, value []any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "IN",
-		Values:     value,
-	}
+// indicates a columns value should be in the provided slice of values.
+//
+// Each value gets its own placeholder(e.g. `"id" IN ($1, $2, $3)`), unless the dialect has been given
+// a different `dialect.InListMode`. If `value` is empty, then the condition is rendered as `1 = 0`.
+func In(column any, value []any) incondition.Condition {
+	return newSimpleCondition(column, "IN", value)
 }
 
 // NotIn returns a condition that can be used in building `WHERE` and `JOIN` clauses that
-// indicates a columns value should not be in the provided slice of values
-func NotIn(columnName string, value []any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "NOT IN",
-		Values:     value,
+// indicates a columns value should not be in the provided slice of values.
+// If `value` is empty, then the condition is rendered as `1 = 1`.
+func NotIn(column any, value []any) incondition.Condition {
+	return newSimpleCondition(column, "NOT IN", value)
+}
+
+// InQuery returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should be in the result set of the provided subquery.
+//
+// For example:
+//
+//	sub := sqlBuilder.Select("orders", "customer_id").Where(condition.GreaterThan("total", 100))
+//	sqlBuilder.Select("customers", "*").Where(condition.InQuery("id", sub))
+//
+// Will result in `SELECT * FROM "customers" WHERE "id" IN (SELECT "customer_id" FROM "orders" WHERE "total" > $1);`
+func InQuery(column any, subquery builders.Builder) incondition.Condition {
+	return newSimpleCondition(column, "IN", []any{inexpr.Subquery{Builder: subquery}})
+}
+
+// NotInQuery returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should not be in the result set of the provided subquery
+func NotInQuery(column any, subquery builders.Builder) incondition.Condition {
+	return newSimpleCondition(column, "NOT IN", []any{inexpr.Subquery{Builder: subquery}})
+}
+
+// Exists returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates the provided subquery should return at least one row
+func Exists(subquery builders.Builder) incondition.Condition {
+	return incondition.ExistsCondition{
+		Operator: "EXISTS",
+		Subquery: inexpr.Subquery{Builder: subquery},
 	}
 }
 
+// NotExists returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates the provided subquery should not return any rows
+func NotExists(subquery builders.Builder) incondition.Condition {
+	return incondition.ExistsCondition{
+		Operator: "NOT EXISTS",
+		Subquery: inexpr.Subquery{Builder: subquery},
+	}
+}
+
+// IsDistinctFrom returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a column should not be equal to the given value, where NULL is treated as a comparable value.
+// MySQL renders this as `NOT (column <=> value)`.
+func IsDistinctFrom(column any, value any) incondition.Condition {
+	return newSimpleCondition(column, "IS DISTINCT FROM", []any{value})
+}
+
+// IsNotDistinctFrom returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a column should be equal to the given value, where NULL is treated as a comparable value.
+// MySQL renders this as `column <=> value`.
+func IsNotDistinctFrom(column any, value any) incondition.Condition {
+	return newSimpleCondition(column, "IS NOT DISTINCT FROM", []any{value})
+}
+
 // Between returns a condition that can be used in building `WHERE` and `JOIN` clauses that
 // indicates a columns value should be between the two provided values
-func Between(columnName string, val1, val2 any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "BETWEEN",
-		Values:     []any{val1, val2},
-	}
+func Between(column any, val1, val2 any) incondition.Condition {
+	return newSimpleCondition(column, "BETWEEN", []any{val1, val2})
 }
 
 // NotBetween returns a condition that can be used in building `WHERE` and `JOIN` clauses that
 // indicates a columns value should not be between the two provided values
-func NotBetween(columnName string, val1, val2 any) incondition.Condition {
-	return incondition.SimpleCondition{
-		ColumnName: columnName,
-		Operator:   "NOT BETWEEN",
-		Values:     []any{val1, val2},
-	}
+func NotBetween(column any, val1, val2 any) incondition.Condition {
+	return newSimpleCondition(column, "NOT BETWEEN", []any{val1, val2})
 }
 
 // GroupedAnd returns a grouping of conditions with the AND operator between each of them. This can be used like any other condition
@@ -165,3 +211,126 @@ func GroupedOr(cond1, cond2 incondition.Condition, additionalConds ...inconditio
 		Conditions:  conds,
 	}
 }
+
+// If returns the provided condition when `ok` is true. Otherwise, an empty condition is returned, which is skipped by
+// `Where`, `And`, `Or`, `GroupedAnd`, `GroupedOr` and `join.On`. This allows conditions to be added based on optional filters:
+//
+//	sqlBuilder.Select("users", "*").Where(
+//	  condition.If(filter.Name != "", condition.Equals("name", filter.Name)),
+//	  condition.If(filter.MinAge > 0, condition.GreaterThanEqual("age", filter.MinAge)),
+//	)
+//
+// If every condition of a `WHERE` clause is skipped, then the `WHERE` clause is omitted entirely.
+func If(ok bool, cond incondition.Condition) incondition.Condition {
+	if !ok {
+		return incondition.EmptyCondition{}
+	}
+	return cond
+}
+
+// NotZero returns a condition that equates a column to the provided value, unless the value is the zero value of its type
+// (e.g. "", 0 or a nil pointer). In which case, an empty condition is returned that is skipped like those returned by `If`.
+// Pointers are dereferenced, so `condition.NotZero("status", statusPtr)` only filters on "status" when `statusPtr` is not nil.
+func NotZero(column any, value any) incondition.Condition {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() || rv.IsZero() {
+		return incondition.EmptyCondition{}
+	}
+
+	if rv.Kind() == reflect.Pointer {
+		value = rv.Elem().Interface()
+	}
+	return Equals(column, value)
+}
+
+// Not returns a condition that negates the provided condition. This can be used with any condition, including grouped conditions.
+// For example if you wanted to create the condition `NOT (t1.col1 = 42 OR t1.col2 < 55)`, then you can use `Not` like so:
+//
+//	condition.Not(condition.GroupedOr(condition.Equals("t1.col1", 42), condition.LessThan("t1.col2", 55)))
+func Not(cond incondition.Condition) incondition.Condition {
+	return incondition.NotCondition{Condition: cond}
+}
+
+// newPatternCondition creates a PatternCondition that matches the provided column against `pattern`
+func newPatternCondition(column any, operator string, pattern any) incondition.PatternCondition {
+	return incondition.PatternCondition{
+		SimpleCondition: newSimpleCondition(column, operator, []any{pattern}),
+	}
+}
+
+// Like returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should match the provided `LIKE` pattern.
+// An escape character can be set by calling `Escape` on the returned condition. e.g. `condition.Like("code", "50!%%").Escape('!')`
+func Like(column any, pattern any) incondition.PatternCondition {
+	return newPatternCondition(column, "LIKE", pattern)
+}
+
+// NotLike returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should not match the provided `LIKE` pattern
+func NotLike(column any, pattern any) incondition.PatternCondition {
+	return newPatternCondition(column, "NOT LIKE", pattern)
+}
+
+// ILike returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should match the provided `LIKE` pattern without regard to case.
+// Dialects that do not support `ILIKE` will instead render `LOWER(column) LIKE LOWER(pattern)`.
+func ILike(column any, pattern any) incondition.PatternCondition {
+	return newPatternCondition(column, "ILIKE", pattern)
+}
+
+// NotILike returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should not match the provided `LIKE` pattern without regard to case
+func NotILike(column any, pattern any) incondition.PatternCondition {
+	return newPatternCondition(column, "NOT ILIKE", pattern)
+}
+
+// SimilarTo returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should match the provided SQL regular expression
+func SimilarTo(column any, pattern any) incondition.PatternCondition {
+	return newPatternCondition(column, "SIMILAR TO", pattern)
+}
+
+// Regex returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should match the provided POSIX regular expression(`~`)
+func Regex(column any, pattern any) incondition.PatternCondition {
+	return newPatternCondition(column, "~", pattern)
+}
+
+// IRegex returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should match the provided POSIX regular expression without regard to case(`~*`)
+func IRegex(column any, pattern any) incondition.PatternCondition {
+	return newPatternCondition(column, "~*", pattern)
+}
+
+// PatternEscapeChar is the escape character used by `Contains`, `StartsWith` and `EndsWith`
+const PatternEscapeChar = '\\'
+
+// Contains returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should contain `value`. Any wildcards within `value` are escaped, so it is matched literally.
+//
+// For example:
+//
+//	condition.Contains("name", "50%_off")
+//
+// Will result in `"name" LIKE $1 ESCAPE $2`, where the parameters are `%50\%\_off%` and `\`
+func Contains(column any, value string) incondition.PatternCondition {
+	return Like(column, "%"+EscapePattern(value, PatternEscapeChar)+"%").Escape(PatternEscapeChar)
+}
+
+// StartsWith returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should start with `value`. Any wildcards within `value` are escaped, so it is matched literally.
+func StartsWith(column any, value string) incondition.PatternCondition {
+	return Like(column, EscapePattern(value, PatternEscapeChar)+"%").Escape(PatternEscapeChar)
+}
+
+// EndsWith returns a condition that can be used in building `WHERE` and `JOIN` clauses that
+// indicates a columns value should end with `value`. Any wildcards within `value` are escaped, so it is matched literally.
+func EndsWith(column any, value string) incondition.PatternCondition {
+	return Like(column, "%"+EscapePattern(value, PatternEscapeChar)).Escape(PatternEscapeChar)
+}
+
+// EscapePattern escapes the `LIKE` wildcards("%" and "_"), as well as `escapeChar` itself, within `value`.
+// This allows user input to be safely embedded into a pattern that is used with `escapeChar` as its escape character.
+func EscapePattern(value string, escapeChar rune) string {
+	return incondition.EscapePattern(value, escapeChar)
+}
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
 )
 
 func TestColumnValue(t *testing.T) {
@@ -35,7 +37,7 @@ func TestColumnValue(t *testing.T) {
 
 func TestEquals(t *testing.T) {
 	type args struct {
-		columnName string
+		columnName any
 		value      any
 	}
 	tests := []struct {
@@ -55,6 +57,30 @@ func TestEquals(t *testing.T) {
 				Values:     []any{"testing"},
 			},
 		},
+		{
+			name: "Success; Expression",
+			args: args{
+				columnName: inexpr.Function{Name: "LOWER", Args: []any{"col1"}},
+				value:      "testing",
+			},
+			want: incondition.SimpleCondition{
+				Expression: inexpr.Function{Name: "LOWER", Args: []any{"col1"}},
+				Operator:   "=",
+				Values:     []any{"testing"},
+			},
+		},
+		{
+			name: "Invalid Column Type",
+			args: args{
+				columnName: 42,
+				value:      "testing",
+			},
+			want: incondition.SimpleCondition{
+				Expression: invalidColumn{value: 42},
+				Operator:   "=",
+				Values:     []any{"testing"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -430,3 +456,194 @@ func TestGroupedOr(t *testing.T) {
 		})
 	}
 }
+
+func Test_invalidColumn_Parameterize(t *testing.T) {
+	_, _, err := invalidColumn{value: 42}.Parameterize(dialect.Postgres)
+	assert.Error(t, err)
+}
+
+// testSubqueryBuilder is a minimal query builder used as the subquery of conditions
+type testSubqueryBuilder struct{}
+
+func (testSubqueryBuilder) Build() (string, []any, error) {
+	return `SELECT "id" FROM "t2";`, nil, nil
+}
+
+func TestSubqueryConditions(t *testing.T) {
+	subquery := inexpr.Subquery{Builder: testSubqueryBuilder{}}
+
+	tests := []struct {
+		name string
+		got  incondition.Condition
+		want incondition.Condition
+	}{
+		{
+			name: "InQuery",
+			got:  InQuery("col1", testSubqueryBuilder{}),
+			want: incondition.SimpleCondition{ColumnName: "col1", Operator: "IN", Values: []any{subquery}},
+		},
+		{
+			name: "NotInQuery",
+			got:  NotInQuery("col1", testSubqueryBuilder{}),
+			want: incondition.SimpleCondition{ColumnName: "col1", Operator: "NOT IN", Values: []any{subquery}},
+		},
+		{
+			name: "Exists",
+			got:  Exists(testSubqueryBuilder{}),
+			want: incondition.ExistsCondition{Operator: "EXISTS", Subquery: subquery},
+		},
+		{
+			name: "NotExists",
+			got:  NotExists(testSubqueryBuilder{}),
+			want: incondition.ExistsCondition{Operator: "NOT EXISTS", Subquery: subquery},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestPatternConditions(t *testing.T) {
+	newPattern := func(operator string, pattern any, escapeChar rune) incondition.PatternCondition {
+		return incondition.PatternCondition{
+			SimpleCondition: incondition.SimpleCondition{ColumnName: "col1", Operator: operator, Values: []any{pattern}},
+			EscapeChar:      escapeChar,
+		}
+	}
+
+	tests := []struct {
+		name string
+		got  incondition.Condition
+		want incondition.Condition
+	}{
+		{
+			name: "Like",
+			got:  Like("col1", "a%"),
+			want: newPattern("LIKE", "a%", 0),
+		},
+		{
+			name: "NotLike w/ Escape",
+			got:  NotLike("col1", "a!%%").Escape('!'),
+			want: newPattern("NOT LIKE", "a!%%", '!'),
+		},
+		{
+			name: "ILike",
+			got:  ILike("col1", "a%"),
+			want: newPattern("ILIKE", "a%", 0),
+		},
+		{
+			name: "NotILike",
+			got:  NotILike("col1", "a%"),
+			want: newPattern("NOT ILIKE", "a%", 0),
+		},
+		{
+			name: "SimilarTo",
+			got:  SimilarTo("col1", "%(a|b)%"),
+			want: newPattern("SIMILAR TO", "%(a|b)%", 0),
+		},
+		{
+			name: "Regex",
+			got:  Regex("col1", "^a"),
+			want: newPattern("~", "^a", 0),
+		},
+		{
+			name: "IRegex",
+			got:  IRegex("col1", "^a"),
+			want: newPattern("~*", "^a", 0),
+		},
+		{
+			name: "Contains",
+			got:  Contains("col1", `50%_off\`),
+			want: newPattern("LIKE", `%50\%\_off\\%`, '\\'),
+		},
+		{
+			name: "StartsWith",
+			got:  StartsWith("col1", "a_b"),
+			want: newPattern("LIKE", `a\_b%`, '\\'),
+		},
+		{
+			name: "EndsWith",
+			got:  EndsWith("col1", "a%"),
+			want: newPattern("LIKE", `%a\%`, '\\'),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestNot(t *testing.T) {
+	cond := GroupedAnd(Equals("col1", 42), IsNull("col2"))
+	assert.Equal(t, incondition.NotCondition{Condition: cond}, Not(cond))
+}
+
+func TestDistinctFromConditions(t *testing.T) {
+	assert.Equal(
+		t,
+		incondition.SimpleCondition{ColumnName: "col1", Operator: "IS DISTINCT FROM", Values: []any{nil}},
+		IsDistinctFrom("col1", nil),
+	)
+	assert.Equal(
+		t,
+		incondition.SimpleCondition{ColumnName: "col1", Operator: "IS NOT DISTINCT FROM", Values: []any{42}},
+		IsNotDistinctFrom("col1", 42),
+	)
+}
+
+func TestIf(t *testing.T) {
+	cond := Equals("col1", 42)
+	assert.Equal(t, cond, If(true, cond))
+	assert.Equal(t, incondition.EmptyCondition{}, If(false, cond))
+}
+
+func TestNotZero(t *testing.T) {
+	status := "active"
+	var nilStatus *string
+	emptyStatus := ""
+
+	tests := []struct {
+		name  string
+		value any
+		want  incondition.Condition
+	}{
+		{
+			name:  "Value",
+			value: 42,
+			want:  incondition.SimpleCondition{ColumnName: "col1", Operator: "=", Values: []any{42}},
+		},
+		{
+			name:  "Pointer",
+			value: &status,
+			want:  incondition.SimpleCondition{ColumnName: "col1", Operator: "=", Values: []any{"active"}},
+		},
+		{
+			name:  "Pointer to Zero Value",
+			value: &emptyStatus,
+			want:  incondition.SimpleCondition{ColumnName: "col1", Operator: "=", Values: []any{""}},
+		},
+		{
+			name:  "Zero Value",
+			value: 0,
+			want:  incondition.EmptyCondition{},
+		},
+		{
+			name:  "Nil Pointer",
+			value: nilStatus,
+			want:  incondition.EmptyCondition{},
+		},
+		{
+			name:  "Nil",
+			value: nil,
+			want:  incondition.EmptyCondition{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NotZero("col1", tt.value))
+		})
+	}
+}
@@ -1,2 +1,5 @@
-// package condition contains functions that build specific types of conditions to use in the query builder
+// package condition contains functions that build specific types of conditions to use in the query builder.
+// The column of a condition can either be a column name or an expression from the `expr` package.
+// A condition's value can also be a query builder, in which case the query will be embedded as a subquery with its
+// parameters numbered along with the rest of the query.
 package condition
@@ -0,0 +1,182 @@
+package condition
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/dialect"
+	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	"github.com/williabk198/jagsqlb/internal/utilities/parsers"
+)
+
+// FilterOption changes how `FromStruct` and `FromMap` create their conditions
+type FilterOption int
+
+const (
+	// SkipZero skips any value that is the zero value of its type(e.g. "", 0 or a nil pointer), as well as empty slices.
+	// Without it, a nil pointer results in an "IS NULL" condition.
+	SkipZero FilterOption = iota + 1
+)
+
+// FromStruct returns a grouping of conditions, with the AND operator between each of them, that compares each column of
+// `filter` to its value, which can either be a struct or a pointer to one. The columns are found using the `jagsqlb` struct tag in the same way as `SetStruct` and `Data`.
+//
+// Each column is compared using "=", unless its tag has an "op" option(e.g. `jagsqlb:"price;op=>="`). The supported operators
+// are "=", "!=", "<>", "<", "<=", ">", ">=", "LIKE", "NOT LIKE", "ILIKE", "NOT ILIKE", "IN" and "NOT IN".
+// Pointers are dereferenced, and slices become an "IN" condition. A nil pointer becomes an "IS NULL" condition,
+// or an "IS NOT NULL" condition if the operator is "!=".
+//
+// For example:
+//
+//	type ProductFilter struct {
+//	  Category *string  `jagsqlb:"category"`
+//	  MinPrice float64  `jagsqlb:"price;op=>="`
+//	  Tags     []string `jagsqlb:"tag"`
+//	}
+//
+//	sqlBuilder.Select("products", "*").Where(condition.FromStruct(ProductFilter{MinPrice: 10}, condition.SkipZero))
+//
+// Will result in `SELECT * FROM "products" WHERE ("price" >= $1);`. If no conditions are left once the zero values are skipped,
+// then the returned condition is skipped just like those returned by `If`.
+func FromStruct(filter any, opts ...FilterOption) incondition.Condition {
+	// Allow a pointer to the filter to be provided as well
+	rv := reflect.ValueOf(filter)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.IsValid() {
+		filter = rv.Interface()
+	}
+
+	cols, ops, vals, err := parsers.ParseFilterTag(filter)
+	if err != nil {
+		return invalidCondition{err: fmt.Errorf("failed to parse filter struct: %w", err)}
+	}
+
+	return newFilterConditions(cols, ops, vals, opts)
+}
+
+// FromMap returns a grouping of conditions, with the AND operator between each of them, that compares each column in `filter`
+// to its value. The conditions are ordered by column name and follow the same rules as `FromStruct`, except that every
+// column is compared using "=", or "IN" for slices.
+func FromMap(filter map[string]any, opts ...FilterOption) incondition.Condition {
+	cols := slices.Sorted(maps.Keys(filter))
+	ops := make([]string, len(cols))
+	vals := make([]any, len(cols))
+	for i, col := range cols {
+		vals[i] = filter[col]
+	}
+
+	return newFilterConditions(cols, ops, vals, opts)
+}
+
+// newFilterConditions creates the grouping of conditions returned by `FromStruct` and `FromMap`
+func newFilterConditions(cols []string, ops []string, vals []any, opts []FilterOption) incondition.Condition {
+	skipZero := slices.Contains(opts, SkipZero)
+
+	conds := make([]incondition.Condition, 0, len(cols))
+	for i, col := range cols {
+		if skipZero && isZeroFilterValue(vals[i]) {
+			continue
+		}
+
+		cond, err := newFilterCondition(col, ops[i], vals[i])
+		if err != nil {
+			return invalidCondition{err: err}
+		}
+		conds = append(conds, cond)
+	}
+
+	if len(conds) == 0 {
+		return incondition.EmptyCondition{}
+	}
+
+	return incondition.GroupedConditions{
+		Conjunction: "AND",
+		Conditions:  conds,
+	}
+}
+
+// newFilterCondition creates the condition that compares `column` to `value` using the provided operator
+func newFilterCondition(column string, operator string, value any) (incondition.Condition, error) {
+	operator = strings.ToUpper(operator)
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if !rv.IsValid() || rv.Kind() == reflect.Pointer {
+		switch operator {
+		case "", "=":
+			return IsNull(column), nil
+		case "!=", "<>":
+			return IsNotNull(column), nil
+		}
+		return nil, fmt.Errorf("cannot use the %q operator to compare column %q to NULL", operator, column)
+	}
+
+	// Byte slices are a single value rather than a list of values
+	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Type().Elem().Kind() != reflect.Uint8 {
+		list := make([]any, rv.Len())
+		for i := range list {
+			list[i] = rv.Index(i).Interface()
+		}
+
+		switch operator {
+		case "", "=", "IN":
+			return In(column, list), nil
+		case "!=", "<>", "NOT IN":
+			return NotIn(column, list), nil
+		}
+		return nil, fmt.Errorf("cannot use the %q operator to compare column %q to a list of values", operator, column)
+	}
+
+	value = rv.Interface()
+	switch operator {
+	case "", "=":
+		return Equals(column, value), nil
+	case "!=", "<>":
+		return NotEquals(column, value), nil
+	case "<":
+		return LessThan(column, value), nil
+	case "<=":
+		return LessThanEqual(column, value), nil
+	case ">":
+		return GreaterThan(column, value), nil
+	case ">=":
+		return GreaterThanEqual(column, value), nil
+	case "LIKE":
+		return Like(column, value), nil
+	case "NOT LIKE":
+		return NotLike(column, value), nil
+	case "ILIKE":
+		return ILike(column, value), nil
+	case "NOT ILIKE":
+		return NotILike(column, value), nil
+	}
+	return nil, fmt.Errorf("unsupported filter operator %q for column %q", operator, column)
+}
+
+// isZeroFilterValue checks to see if the provided value should be skipped when the `SkipZero` option is used
+func isZeroFilterValue(value any) bool {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() || rv.IsZero() {
+		return true
+	}
+
+	return rv.Kind() == reflect.Slice && rv.Len() == 0
+}
+
+// invalidCondition is used in place of a condition that could not be created.
+// This defers the error until the condition is parameterized when the query is built.
+type invalidCondition struct {
+	err error
+}
+
+func (ic invalidCondition) Parameterize(dialect.Dialect) (string, []any, error) {
+	return "", nil, ic.err
+}
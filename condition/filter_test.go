@@ -0,0 +1,154 @@
+package condition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	incondition "github.com/williabk198/jagsqlb/internal/condition"
+)
+
+type testFilter struct {
+	Category *string  `jagsqlb:"category"`
+	Name     string   `jagsqlb:"name;op=ilike"`
+	MinPrice float64  `jagsqlb:"price;op=>="`
+	Tags     []string `jagsqlb:"tag"`
+	Excluded []int    `jagsqlb:"id;op=!="`
+	Internal string   `jagsqlb:";omit"`
+}
+
+func TestFromStruct(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	category := "toys"
+
+	tests := []struct {
+		name      string
+		filter    any
+		opts      []FilterOption
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:   "Success",
+			filter: testFilter{Category: &category, Name: "lego%", MinPrice: 10, Tags: []string{"new", "sale"}, Excluded: []int{7}},
+			wants: wants{
+				query:  `("category" = ? AND "name" ILIKE ? AND "price" >= ? AND "tag" IN (?, ?) AND "id" NOT IN (?))`,
+				params: []any{"toys", "lego%", 10.0, "new", "sale", 7},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:   "Success; Zero Values",
+			filter: testFilter{},
+			wants: wants{
+				query:  `("category" IS NULL AND "name" ILIKE ? AND "price" >= ? AND 1 = 0 AND 1 = 1)`,
+				params: []any{"", 0.0},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:   "Success; Skip Zero Values",
+			filter: testFilter{MinPrice: 10, Tags: []string{}},
+			opts:   []FilterOption{SkipZero},
+			wants: wants{
+				query:  `("price" >= ?)`,
+				params: []any{10.0},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Nil Pointer w/ Not Equals",
+			filter: struct {
+				Deleted *bool `jagsqlb:"deleted_at;op=<>"`
+			}{},
+			wants: wants{
+				query:  `("deleted_at" IS NOT NULL)`,
+				params: []any{},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:   "Success; Pointer",
+			filter: &testFilter{Category: &category, Name: "lego%"},
+			opts:   []FilterOption{SkipZero},
+			wants: wants{
+				query:  `("category" = ? AND "name" ILIKE ?)`,
+				params: []any{"toys", "lego%"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Nil Pointer",
+			filter:    (*testFilter)(nil),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Not a Struct",
+			filter:    "bad",
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Unsupported Operator",
+			filter: struct {
+				Price int `jagsqlb:"price;op=~~"`
+			}{Price: 3},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Unsupported Operator for Lists",
+			filter: struct {
+				Price []int `jagsqlb:"price;op=>"`
+			}{Price: []int{3}},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Unsupported Operator for NULL",
+			filter: struct {
+				Price *int `jagsqlb:"price;op=>"`
+			}{},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := FromStruct(tt.filter, tt.opts...).Parameterize(dialect.Postgres)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestFromStruct_ErrorMessage(t *testing.T) {
+	_, _, err := FromStruct("bad").Parameterize(dialect.Postgres)
+	assert.EqualError(t, err, "failed to parse filter struct: received value is not a struct type")
+}
+
+func TestFromStruct_AllSkipped(t *testing.T) {
+	assert.Equal(t, incondition.EmptyCondition{}, FromStruct(testFilter{}, SkipZero))
+}
+
+func TestFromMap(t *testing.T) {
+	gotQuery, gotParams, err := FromMap(map[string]any{
+		"status":     "active",
+		"deleted_at": nil,
+		"id":         []any{1, 2},
+		"owner":      "",
+		"data":       []byte("raw"),
+	}).Parameterize(dialect.Postgres)
+	assert.NoError(t, err)
+	assert.Equal(t, `("data" = ? AND "deleted_at" IS NULL AND "id" IN (?, ?) AND "owner" = ? AND "status" = ?)`, gotQuery)
+	assert.Equal(t, []any{[]byte("raw"), 1, 2, "", "active"}, gotParams)
+
+	gotQuery, gotParams, err = FromMap(map[string]any{"status": "active", "deleted_at": nil, "owner": ""}, SkipZero).
+		Parameterize(dialect.Postgres)
+	assert.NoError(t, err)
+	assert.Equal(t, `("status" = ?)`, gotQuery)
+	assert.Equal(t, []any{"active"}, gotParams)
+
+	assert.Equal(t, incondition.EmptyCondition{}, FromMap(nil))
+}
@@ -0,0 +1,41 @@
+package jagsqlb
+
+import (
+	incursor "github.com/williabk198/jagsqlb/internal/cursor"
+)
+
+// MinCursorKeySize is the minimum number of bytes that the key given to `EncodeCursor` and `DecodeCursor` must have
+const MinCursorKeySize = incursor.MinKeySize
+
+var (
+	// ErrInvalidCursor is returned by `DecodeCursor` when a cursor is malformed, was created with a different key, or has been tampered with
+	ErrInvalidCursor = incursor.ErrInvalidCursor
+	// ErrShortCursorKey is returned by `EncodeCursor` and `DecodeCursor` when the key has fewer than `MinCursorKeySize` bytes
+	ErrShortCursorKey = incursor.ErrShortKey
+)
+
+// EncodeCursor creates an opaque cursor that holds the values of the last row of a page, so that it can be handed out by an API
+// and later passed to `SeekAfter` with `DecodeCursor`. The cursor is signed using `key`, which should be kept secret and
+// be at least `MinCursorKeySize` bytes long, so that any changes made to it are detected. Each value must either be NULL
+// or a type known to `encoding/gob`, which includes every basic type and `time.Time`. Any other types must be registered
+// with `gob.Register`. NULL values, including nil pointers, are given back as nil by `DecodeCursor`.
+//
+// For example:
+//
+//	cursor, err := jagsqlb.EncodeCursor(secretKey, []any{lastPost.CreatedAt, lastPost.ID})
+func EncodeCursor(key []byte, lastValues []any) (string, error) {
+	return incursor.Encode(key, lastValues)
+}
+
+// DecodeCursor returns the values held by a cursor that was created by `EncodeCursor` using the same key.
+//
+// For example:
+//
+//	lastValues, err := jagsqlb.DecodeCursor(secretKey, cursor)
+//	if err != nil {
+//	    return err
+//	}
+//	query, params, err := sqlBuilder.Select("posts", "*").SeekAfter(orderings, lastValues).Limit(20).Build()
+func DecodeCursor(key []byte, cursor string) ([]any, error) {
+	return incursor.Decode(key, cursor)
+}
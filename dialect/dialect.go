@@ -0,0 +1,180 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Clause represents a portion of a query that not every SQL dialect is able to express
+type Clause string
+
+const (
+	ClauseAnyArray           Clause = "= ANY(array)"
+	ClauseDefaultValues      Clause = "DEFAULT VALUES"
+	ClauseDeleteJoin         Clause = "DELETE ... JOIN"
+	ClauseDeleteUsing        Clause = "DELETE ... USING"
+	ClauseDistinctOn         Clause = "DISTINCT ON"
+	ClauseForKeyShare        Clause = "FOR KEY SHARE"
+	ClauseForNoKeyUpdate     Clause = "FOR NO KEY UPDATE"
+	ClauseForShare           Clause = "FOR SHARE"
+	ClauseForUpdate          Clause = "FOR UPDATE"
+	ClauseFullJoin           Clause = "FULL OUTER JOIN"
+	ClauseILike              Clause = "ILIKE"
+	ClauseIsDistinctFrom     Clause = "IS DISTINCT FROM"
+	ClauseLateral            Clause = "LATERAL"
+	ClauseNaturalJoin        Clause = "NATURAL JOIN"
+	ClauseNestedOrderBy      Clause = "ORDER BY without pagination in a nested query"
+	ClauseNestedWith         Clause = "WITH in a nested query"
+	ClauseNullSafeEqual      Clause = "<=>"
+	ClauseNullsOrdering      Clause = "NULLS FIRST/LAST"
+	ClauseOffsetFetch        Clause = "OFFSET ... FETCH"
+	ClauseOffsetWithoutLimit Clause = "OFFSET without LIMIT"
+	ClauseOnConflict         Clause = "ON CONFLICT"
+	ClauseOnConstraint       Clause = "ON CONFLICT ON CONSTRAINT"
+	ClauseOnDuplicateKey     Clause = "ON DUPLICATE KEY UPDATE"
+	ClauseParenthesizedQuery Clause = "parenthesized compound operand"
+	ClauseQuotedCollation    Clause = "COLLATE \"name\""
+	ClauseRegex              Clause = "~"
+	ClauseReturning          Clause = "RETURNING"
+	ClauseRowValues          Clause = "row value comparison"
+	ClauseSimilarTo          Clause = "SIMILAR TO"
+	ClauseUpdateFrom         Clause = "UPDATE ... FROM"
+	ClauseUpdateJoin         Clause = "UPDATE ... JOIN"
+	ClauseWithRecursive      Clause = "WITH RECURSIVE"
+)
+
+// Dialect defines how a query is rendered for a specific database engine
+type Dialect interface {
+	// Name returns the human readable name of the dialect
+	Name() string
+	// Placeholder returns the placeholder for the query parameter at the given position.
+	// Positions start at 1.
+	Placeholder(position int) string
+	// QuoteIdentifier wraps the provided identifier(e.g. table, column or schema name) in the dialect's quoting characters
+	QuoteIdentifier(identifier string) string
+	// Supports reports whether the dialect is able to express the provided clause
+	Supports(clause Clause) bool
+}
+
+var (
+	// Postgres renders queries for PostgreSQL. This is the default dialect.
+	Postgres Dialect = postgres{}
+	// MySQL renders queries for MySQL and MariaDB
+	MySQL Dialect = mysql{}
+	// SQLite renders queries for SQLite
+	SQLite Dialect = sqlite{}
+	// SQLServer renders queries for Microsoft SQL Server
+	SQLServer Dialect = sqlServer{}
+)
+
+type postgres struct{}
+
+func (postgres) Name() string {
+	return "PostgreSQL"
+}
+
+func (postgres) Placeholder(position int) string {
+	return fmt.Sprintf("$%d", position)
+}
+
+func (postgres) QuoteIdentifier(identifier string) string {
+	return quote(identifier, `"`, `"`)
+}
+
+func (postgres) Supports(clause Clause) bool {
+	switch clause {
+	case ClauseOffsetFetch:
+		// While PostgreSQL does support "OFFSET ... FETCH", "LIMIT" and "OFFSET" are preferred
+		return false
+	case ClauseDeleteJoin, ClauseNullSafeEqual, ClauseOnDuplicateKey, ClauseUpdateJoin:
+		return false
+	default:
+		return true
+	}
+}
+
+type mysql struct{}
+
+func (mysql) Name() string {
+	return "MySQL"
+}
+
+func (mysql) Placeholder(int) string {
+	return "?"
+}
+
+func (mysql) QuoteIdentifier(identifier string) string {
+	return quote(identifier, "`", "`")
+}
+
+func (mysql) Supports(clause Clause) bool {
+	switch clause {
+	case ClauseAnyArray, ClauseDefaultValues, ClauseDeleteUsing, ClauseDistinctOn, ClauseForKeyShare, ClauseForNoKeyUpdate, ClauseFullJoin,
+		ClauseILike, ClauseIsDistinctFrom, ClauseNullsOrdering, ClauseOffsetFetch, ClauseOffsetWithoutLimit, ClauseOnConflict,
+		ClauseOnConstraint, ClauseRegex, ClauseReturning, ClauseSimilarTo, ClauseUpdateFrom:
+		return false
+	default:
+		return true
+	}
+}
+
+type sqlite struct{}
+
+func (sqlite) Name() string {
+	return "SQLite"
+}
+
+func (sqlite) Placeholder(int) string {
+	return "?"
+}
+
+func (sqlite) QuoteIdentifier(identifier string) string {
+	return quote(identifier, `"`, `"`)
+}
+
+func (sqlite) Supports(clause Clause) bool {
+	switch clause {
+	// SQLite only allows the last query of a compound query to be ordered or paginated, and doesn't allow its queries to be parenthesized
+	case ClauseAnyArray, ClauseDeleteJoin, ClauseDeleteUsing, ClauseDistinctOn, ClauseForKeyShare, ClauseForNoKeyUpdate, ClauseForShare,
+		ClauseForUpdate, ClauseILike, ClauseLateral, ClauseNullSafeEqual, ClauseOffsetFetch, ClauseOffsetWithoutLimit, ClauseOnConstraint,
+		ClauseOnDuplicateKey, ClauseParenthesizedQuery, ClauseRegex, ClauseSimilarTo, ClauseUpdateJoin:
+		return false
+	default:
+		return true
+	}
+}
+
+type sqlServer struct{}
+
+func (sqlServer) Name() string {
+	return "SQL Server"
+}
+
+func (sqlServer) Placeholder(position int) string {
+	return fmt.Sprintf("@p%d", position)
+}
+
+func (sqlServer) QuoteIdentifier(identifier string) string {
+	return quote(identifier, "[", "]")
+}
+
+func (sqlServer) Supports(clause Clause) bool {
+	switch clause {
+	// SQL Server allows common table expressions to be recursive without the "RECURSIVE" keyword, locks rows with table hints
+	// rather than a locking clause and uses "APPLY" rather than "LATERAL". Collation names can't be quoted, and nested queries
+	// can only be ordered when they are also paginated. A WITH clause can only precede the outermost statement.
+	case ClauseAnyArray, ClauseDeleteJoin, ClauseDeleteUsing, ClauseDistinctOn, ClauseForKeyShare, ClauseForNoKeyUpdate, ClauseForShare,
+		ClauseForUpdate, ClauseILike, ClauseLateral, ClauseNaturalJoin, ClauseNestedOrderBy, ClauseNestedWith, ClauseNullSafeEqual,
+		ClauseNullsOrdering, ClauseOnConflict, ClauseOnConstraint, ClauseOnDuplicateKey, ClauseQuotedCollation, ClauseRegex, ClauseReturning,
+		ClauseRowValues, ClauseSimilarTo, ClauseUpdateJoin, ClauseWithRecursive:
+		return false
+	default:
+		return true
+	}
+}
+
+// quote wraps `identifier` with the `openChar` and `closeChar` characters.
+// Any occurrence of `closeChar` within the identifier is escaped by doubling it.
+func quote(identifier, openChar, closeChar string) string {
+	return openChar + strings.ReplaceAll(identifier, closeChar, closeChar+closeChar) + closeChar
+}
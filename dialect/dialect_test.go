@@ -0,0 +1,388 @@
+package dialect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialect_Placeholder(t *testing.T) {
+	tests := []struct {
+		name     string
+		d        Dialect
+		position int
+		want     string
+	}{
+		{
+			name:     "PostgreSQL",
+			d:        Postgres,
+			position: 3,
+			want:     "$3",
+		},
+		{
+			name:     "MySQL",
+			d:        MySQL,
+			position: 3,
+			want:     "?",
+		},
+		{
+			name:     "SQLite",
+			d:        SQLite,
+			position: 3,
+			want:     "?",
+		},
+		{
+			name:     "SQL Server",
+			d:        SQLServer,
+			position: 3,
+			want:     "@p3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.d.Placeholder(tt.position))
+		})
+	}
+}
+
+func TestDialect_QuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		d          Dialect
+		identifier string
+		want       string
+	}{
+		{
+			name:       "PostgreSQL",
+			d:          Postgres,
+			identifier: "col1",
+			want:       `"col1"`,
+		},
+		{
+			name:       "MySQL",
+			d:          MySQL,
+			identifier: "col1",
+			want:       "`col1`",
+		},
+		{
+			name:       "MySQL w/ Embedded Quote",
+			d:          MySQL,
+			identifier: "col`1",
+			want:       "`col``1`",
+		},
+		{
+			name:       "SQLite",
+			d:          SQLite,
+			identifier: "col1",
+			want:       `"col1"`,
+		},
+		{
+			name:       "SQL Server",
+			d:          SQLServer,
+			identifier: "col1",
+			want:       "[col1]",
+		},
+		{
+			name:       "SQL Server w/ Embedded Bracket",
+			d:          SQLServer,
+			identifier: "col]1",
+			want:       "[col]]1]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.d.QuoteIdentifier(tt.identifier))
+		})
+	}
+}
+
+func TestDialect_Supports(t *testing.T) {
+	tests := []struct {
+		name   string
+		d      Dialect
+		clause Clause
+		want   bool
+	}{
+		{
+			name:   "PostgreSQL; Returning",
+			d:      Postgres,
+			clause: ClauseReturning,
+			want:   true,
+		},
+		{
+			name:   "PostgreSQL; Offset Fetch",
+			d:      Postgres,
+			clause: ClauseOffsetFetch,
+			want:   false,
+		},
+		{
+			name:   "MySQL; Returning",
+			d:      MySQL,
+			clause: ClauseReturning,
+			want:   false,
+		},
+		{
+			name:   "SQLite; Update From",
+			d:      SQLite,
+			clause: ClauseUpdateFrom,
+			want:   true,
+		},
+		{
+			name:   "SQLite; Delete Using",
+			d:      SQLite,
+			clause: ClauseDeleteUsing,
+			want:   false,
+		},
+		{
+			name:   "SQL Server; Offset Fetch",
+			d:      SQLServer,
+			clause: ClauseOffsetFetch,
+			want:   true,
+		},
+		{
+			name:   "SQL Server; With Recursive",
+			d:      SQLServer,
+			clause: ClauseWithRecursive,
+			want:   false,
+		},
+		{
+			name:   "MySQL; With Recursive",
+			d:      MySQL,
+			clause: ClauseWithRecursive,
+			want:   true,
+		},
+		{
+			name:   "PostgreSQL; On Duplicate Key",
+			d:      Postgres,
+			clause: ClauseOnDuplicateKey,
+			want:   false,
+		},
+		{
+			name:   "MySQL; On Conflict",
+			d:      MySQL,
+			clause: ClauseOnConflict,
+			want:   false,
+		},
+		{
+			name:   "MySQL; On Duplicate Key",
+			d:      MySQL,
+			clause: ClauseOnDuplicateKey,
+			want:   true,
+		},
+		{
+			name:   "PostgreSQL; Any Array",
+			d:      Postgres,
+			clause: ClauseAnyArray,
+			want:   true,
+		},
+		{
+			name:   "SQLite; Any Array",
+			d:      SQLite,
+			clause: ClauseAnyArray,
+			want:   false,
+		},
+		{
+			name:   "MySQL; Update Join",
+			d:      MySQL,
+			clause: ClauseUpdateJoin,
+			want:   true,
+		},
+		{
+			name:   "PostgreSQL; Delete Join",
+			d:      Postgres,
+			clause: ClauseDeleteJoin,
+			want:   false,
+		},
+		{
+			name:   "SQL Server; Update Join",
+			d:      SQLServer,
+			clause: ClauseUpdateJoin,
+			want:   false,
+		},
+		{
+			name:   "PostgreSQL; For Key Share",
+			d:      Postgres,
+			clause: ClauseForKeyShare,
+			want:   true,
+		},
+		{
+			name:   "MySQL; For Update",
+			d:      MySQL,
+			clause: ClauseForUpdate,
+			want:   true,
+		},
+		{
+			name:   "MySQL; For No Key Update",
+			d:      MySQL,
+			clause: ClauseForNoKeyUpdate,
+			want:   false,
+		},
+		{
+			name:   "SQLite; For Share",
+			d:      SQLite,
+			clause: ClauseForShare,
+			want:   false,
+		},
+		{
+			name:   "PostgreSQL; ILike",
+			d:      Postgres,
+			clause: ClauseILike,
+			want:   true,
+		},
+		{
+			name:   "MySQL; Regex",
+			d:      MySQL,
+			clause: ClauseRegex,
+			want:   false,
+		},
+		{
+			name:   "SQL Server; Similar To",
+			d:      SQLServer,
+			clause: ClauseSimilarTo,
+			want:   false,
+		},
+		{
+			name:   "MySQL; Is Distinct From",
+			d:      MySQL,
+			clause: ClauseIsDistinctFrom,
+			want:   false,
+		},
+		{
+			name:   "MySQL; Null-Safe Equal",
+			d:      MySQL,
+			clause: ClauseNullSafeEqual,
+			want:   true,
+		},
+		{
+			name:   "PostgreSQL; Null-Safe Equal",
+			d:      Postgres,
+			clause: ClauseNullSafeEqual,
+			want:   false,
+		},
+		{
+			name:   "SQL Server; Nulls Ordering",
+			d:      SQLServer,
+			clause: ClauseNullsOrdering,
+			want:   false,
+		},
+		{
+			name:   "SQLite; Parenthesized Query",
+			d:      SQLite,
+			clause: ClauseParenthesizedQuery,
+			want:   false,
+		},
+		{
+			name:   "MySQL; Parenthesized Query",
+			d:      MySQL,
+			clause: ClauseParenthesizedQuery,
+			want:   true,
+		},
+		{
+			name:   "SQL Server; Nested Order By",
+			d:      SQLServer,
+			clause: ClauseNestedOrderBy,
+			want:   false,
+		},
+		{
+			name:   "SQL Server; Nested With",
+			d:      SQLServer,
+			clause: ClauseNestedWith,
+			want:   false,
+		},
+		{
+			name:   "SQL Server; Quoted Collation",
+			d:      SQLServer,
+			clause: ClauseQuotedCollation,
+			want:   false,
+		},
+		{
+			name:   "MySQL; Quoted Collation",
+			d:      MySQL,
+			clause: ClauseQuotedCollation,
+			want:   true,
+		},
+		{
+			name:   "SQL Server; Row Values",
+			d:      SQLServer,
+			clause: ClauseRowValues,
+			want:   false,
+		},
+		{
+			name:   "MySQL; Row Values",
+			d:      MySQL,
+			clause: ClauseRowValues,
+			want:   true,
+		},
+		{
+			name:   "PostgreSQL; Distinct On",
+			d:      Postgres,
+			clause: ClauseDistinctOn,
+			want:   true,
+		},
+		{
+			name:   "SQLite; Distinct On",
+			d:      SQLite,
+			clause: ClauseDistinctOn,
+			want:   false,
+		},
+		{
+			name:   "MySQL; Lateral",
+			d:      MySQL,
+			clause: ClauseLateral,
+			want:   true,
+		},
+		{
+			name:   "SQL Server; Lateral",
+			d:      SQLServer,
+			clause: ClauseLateral,
+			want:   false,
+		},
+		{
+			name:   "MySQL; Full Join",
+			d:      MySQL,
+			clause: ClauseFullJoin,
+			want:   false,
+		},
+		{
+			name:   "SQLite; Full Join",
+			d:      SQLite,
+			clause: ClauseFullJoin,
+			want:   true,
+		},
+		{
+			name:   "SQL Server; Natural Join",
+			d:      SQLServer,
+			clause: ClauseNaturalJoin,
+			want:   false,
+		},
+		{
+			name:   "SQLite; On Constraint",
+			d:      SQLite,
+			clause: ClauseOnConstraint,
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.d.Supports(tt.clause))
+		})
+	}
+}
+
+func TestWithInListMode(t *testing.T) {
+	// Every dialect expands lists unless it has been given a different mode, even those that support ANY
+	assert.Equal(t, InListExpand, InListModeOf(Postgres))
+	assert.Equal(t, InListExpand, InListModeOf(MySQL))
+	assert.Equal(t, InListExpand, InListModeOf(SQLite))
+	assert.Equal(t, InListExpand, InListModeOf(SQLServer))
+
+	d := WithInListMode(Postgres, InListAny)
+	assert.Equal(t, InListAny, InListModeOf(d))
+	assert.Equal(t, "$2", d.Placeholder(2))
+	assert.True(t, d.Supports(ClauseAnyArray))
+
+	// Changing the mode again should replace the previous mode rather than wrap it
+	d = WithInListMode(d, InListExpand)
+	assert.Equal(t, InListExpand, InListModeOf(d))
+	assert.Equal(t, inListDialect{Dialect: Postgres, mode: InListExpand}, d)
+}
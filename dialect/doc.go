@@ -0,0 +1,3 @@
+// package dialect holds the SQL dialects that can be used to render queries,
+// as well as the Dialect interface to define your own
+package dialect
@@ -0,0 +1,46 @@
+package dialect
+
+// InListMode determines how a condition on a list of values, such as `condition.In`, is rendered
+type InListMode int
+
+const (
+	// InListExpand renders each value of the list as its own placeholder. e.g. `"col" IN ($1, $2, $3)`.
+	// This is the default for every dialect.
+	InListExpand InListMode = iota
+	// InListAny renders the whole list as a single array parameter. e.g. `"col" = ANY($1)`.
+	// Only dialects that support `ClauseAnyArray` are able to use this mode.
+	InListAny
+)
+
+// inListDialect overrides the InListMode of the dialect that it wraps
+type inListDialect struct {
+	Dialect
+	mode InListMode
+}
+
+func (ild inListDialect) InListMode() InListMode {
+	return ild.mode
+}
+
+// WithInListMode returns a copy of `d` that renders conditions on a list of values using the provided mode.
+//
+// For example:
+//
+//	sqlBuilder := jagsqlb.NewSqlBuilder(jagsqlb.WithDialect(dialect.WithInListMode(dialect.Postgres, dialect.InListAny)))
+//	sqlBuilder.Select("users", "*").Where(condition.In("id", []any{1, 2, 3}))
+//
+// Will result in `SELECT * FROM "users" WHERE "id" = ANY($1);`, where the single parameter holds every value
+func WithInListMode(d Dialect, mode InListMode) Dialect {
+	if ild, ok := d.(inListDialect); ok {
+		d = ild.Dialect
+	}
+	return inListDialect{Dialect: d, mode: mode}
+}
+
+// InListModeOf returns the InListMode that `d` uses to render conditions on a list of values
+func InListModeOf(d Dialect) InListMode {
+	if moder, ok := d.(interface{ InListMode() InListMode }); ok {
+		return moder.InListMode()
+	}
+	return InListExpand
+}
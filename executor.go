@@ -0,0 +1,33 @@
+package jagsqlb
+
+import (
+	"context"
+
+	"github.com/williabk198/jagsqlb/builders"
+	inbuilders "github.com/williabk198/jagsqlb/internal/builders"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+// QueryError is returned when a built query fails to run. The query that was run is available in its `Query` field,
+// and the error returned by the database can be retrieved with `errors.Unwrap`, `errors.Is` or `errors.As`.
+type QueryError = intypes.QueryError
+
+// Get builds the query of `b`, runs it using `db`, and reads the first row of the result set into a value of type T,
+// following the same rules as `ScanAll`. If there are no rows, then an error wrapping `sql.ErrNoRows` is returned.
+//
+// For example:
+//
+//	person, err := jagsqlb.Get[Person](ctx, db, sqlBuilder.Select("people", "*").Where(condition.Equals("id", 1)))
+func Get[T any](ctx context.Context, db builders.QueryerContext, b builders.Builder) (T, error) {
+	return inbuilders.Get[T](ctx, db, b)
+}
+
+// Select builds the query of `b`, runs it using `db`, and reads every row of the result set into a value of type T,
+// following the same rules as `ScanAll`.
+//
+// For example:
+//
+//	people, err := jagsqlb.Select[Person](ctx, db, sqlBuilder.Select("people", "*").Limit(10))
+func Select[T any](ctx context.Context, db builders.QueryerContext, b builders.Builder) ([]T, error) {
+	return inbuilders.Select[T](ctx, db, b)
+}
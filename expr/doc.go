@@ -0,0 +1,6 @@
+// package expr contains functions that build expressions, such as aggregate function calls, that can be used in place of
+// columns within the query builder. Expressions can be selected, grouped by, ordered by and used within conditions.
+//
+// Arguments of an expression that are strings are treated as column references, arguments that are expressions are nested,
+// and any other argument is bound as a query parameter. Use `Value` to bind a string as a parameter.
+package expr
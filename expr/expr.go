@@ -0,0 +1,145 @@
+package expr
+
+import (
+	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+)
+
+// Col returns an expression that references a table column.
+// Arithmetic can be performed on the column with its Plus, Minus, Times and Div methods.
+//
+// For example:
+//
+//	expr.Col("stock").Minus(1)
+//
+// Will result in `"stock" - $1` with the parameter 1.
+func Col(column string) inexpr.Column {
+	return inexpr.Column{Name: column}
+}
+
+// Value returns an expression that binds the provided value as a query parameter
+func Value(value any) inexpr.Value {
+	return inexpr.Value{Value: value}
+}
+
+// Now returns an expression for the current date and time, rendered as `CURRENT_TIMESTAMP`
+func Now() inexpr.Keyword {
+	return inexpr.Keyword{Keyword: "CURRENT_TIMESTAMP"}
+}
+
+// Default returns an expression that sets a column to its default value when used as a value in an "UPDATE" statement
+//
+// For example:
+//
+//	jagsqlb.NewSqlBuilder().Update("users").Set("status", expr.Default()).Build()
+//
+// Will result in `UPDATE "users" SET "status"=DEFAULT;`
+func Default() inexpr.Keyword {
+	return inexpr.Keyword{Keyword: "DEFAULT"}
+}
+
+// Func returns an expression that calls the SQL function with the given name and arguments.
+// This can be used for any function that does not have a dedicated helper in this package.
+//
+// For example:
+//
+//	expr.Func("DATE_TRUNC", expr.Value("day"), "o.created_at").As("day")
+//
+// Will result in `DATE_TRUNC($1, "o"."created_at") AS "day"` with the parameter "day".
+func Func(name string, args ...any) inexpr.Function {
+	return inexpr.Function{
+		Name: name,
+		Args: args,
+	}
+}
+
+// Count returns an expression that counts the rows where the argument is not NULL. Use "*" to count every row.
+//
+// For example:
+//
+//	jagsqlb.NewSqlBuilder().Select("orders", expr.Count("*").As("total")).Build()
+//
+// Will result in `SELECT COUNT(*) AS "total" FROM "orders";`
+func Count(arg any) inexpr.Function {
+	return Func("COUNT", arg)
+}
+
+// CountDistinct returns an expression that counts the distinct, non-NULL values of the argument
+func CountDistinct(arg any) inexpr.Function {
+	return inexpr.Function{
+		Name:     "COUNT",
+		Args:     []any{arg},
+		Distinct: true,
+	}
+}
+
+// Sum returns an expression that totals the values of the argument
+func Sum(arg any) inexpr.Function {
+	return Func("SUM", arg)
+}
+
+// Avg returns an expression that averages the values of the argument
+func Avg(arg any) inexpr.Function {
+	return Func("AVG", arg)
+}
+
+// Min returns an expression that finds the smallest value of the argument
+func Min(arg any) inexpr.Function {
+	return Func("MIN", arg)
+}
+
+// Max returns an expression that finds the largest value of the argument
+func Max(arg any) inexpr.Function {
+	return Func("MAX", arg)
+}
+
+// Coalesce returns an expression that evaluates to the first of its arguments that is not NULL
+//
+// For example:
+//
+//	expr.Coalesce("o.discount", 0)
+//
+// Will result in `COALESCE("o"."discount", $1)` with the parameter 0.
+func Coalesce(arg any, moreArgs ...any) inexpr.Function {
+	return Func("COALESCE", append([]any{arg}, moreArgs...)...)
+}
+
+// Lower returns an expression that converts the argument to lower case
+func Lower(arg any) inexpr.Function {
+	return Func("LOWER", arg)
+}
+
+// Upper returns an expression that converts the argument to upper case
+func Upper(arg any) inexpr.Function {
+	return Func("UPPER", arg)
+}
+
+// Excluded returns an expression that references a column of the row that was proposed for insertion when it conflicts
+// with an existing row. It is intended to be used as a value within `DoUpdateSet` of an "INSERT" statement.
+//
+// For example:
+//
+//	jagsqlb.NewSqlBuilder().Insert("stock").Columns("sku", "qty").Values([]any{"abc", 5}).OnConflict("sku").DoUpdateSet(
+//	    map[string]any{"qty": expr.Excluded("qty")},
+//	).Build()
+//
+// Will result in `INSERT INTO "stock" ("sku", "qty") VALUES ($1, $2) ON CONFLICT ("sku") DO UPDATE SET "qty" = EXCLUDED."qty";`.
+// For MySQL, this will instead be rendered as "VALUES(`qty`)". MySQL 8.0.20 deprecated this use of `VALUES()` in favour
+// of a row alias, but the row alias isn't understood by MariaDB or MySQL before 8.0.19, so `VALUES()` is still used.
+func Excluded(column string) inexpr.Excluded {
+	return inexpr.Excluded{Column: column}
+}
+
+// When returns a "CASE" expression that evaluates to `result` when `condition` is true. More branches can be added with
+// its When method, and the result for when none of the conditions are true can be set with its Else method.
+// If a result is a string, then it is treated as a column reference. Any other value is bound as a query parameter.
+//
+// For example:
+//
+//	expr.When(condition.Equals("status", "urgent"), 0).When(condition.Equals("status", "open"), 1).Else(2)
+//
+// Will result in `CASE WHEN "status" = $1 THEN $2 WHEN "status" = $3 THEN $4 ELSE $5 END`
+// with the parameters "urgent", 0, "open", 1 and 2.
+func When(condition incondition.Condition, result any) inexpr.Case {
+	return inexpr.Case{}.When(condition, result)
+}
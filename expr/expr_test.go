@@ -0,0 +1,99 @@
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+)
+
+func TestCol(t *testing.T) {
+	assert.Equal(t, inexpr.Column{Name: "t1.col1"}, Col("t1.col1"))
+}
+
+func TestNow(t *testing.T) {
+	assert.Equal(t, inexpr.Keyword{Keyword: "CURRENT_TIMESTAMP"}, Now())
+}
+
+func TestDefault(t *testing.T) {
+	assert.Equal(t, inexpr.Keyword{Keyword: "DEFAULT"}, Default())
+}
+
+func TestValue(t *testing.T) {
+	assert.Equal(t, inexpr.Value{Value: 42}, Value(42))
+}
+
+func TestFunctions(t *testing.T) {
+	tests := []struct {
+		name string
+		got  inexpr.Function
+		want inexpr.Function
+	}{
+		{
+			name: "Func",
+			got:  Func("DATE_TRUNC", Value("day"), "created_at"),
+			want: inexpr.Function{Name: "DATE_TRUNC", Args: []any{inexpr.Value{Value: "day"}, "created_at"}},
+		},
+		{
+			name: "Count",
+			got:  Count("*"),
+			want: inexpr.Function{Name: "COUNT", Args: []any{"*"}},
+		},
+		{
+			name: "CountDistinct",
+			got:  CountDistinct("col1"),
+			want: inexpr.Function{Name: "COUNT", Args: []any{"col1"}, Distinct: true},
+		},
+		{
+			name: "Sum",
+			got:  Sum("col1"),
+			want: inexpr.Function{Name: "SUM", Args: []any{"col1"}},
+		},
+		{
+			name: "Avg",
+			got:  Avg("col1"),
+			want: inexpr.Function{Name: "AVG", Args: []any{"col1"}},
+		},
+		{
+			name: "Min",
+			got:  Min("col1"),
+			want: inexpr.Function{Name: "MIN", Args: []any{"col1"}},
+		},
+		{
+			name: "Max",
+			got:  Max("col1"),
+			want: inexpr.Function{Name: "MAX", Args: []any{"col1"}},
+		},
+		{
+			name: "Coalesce",
+			got:  Coalesce("col1", "col2", 0),
+			want: inexpr.Function{Name: "COALESCE", Args: []any{"col1", "col2", 0}},
+		},
+		{
+			name: "Lower",
+			got:  Lower("col1"),
+			want: inexpr.Function{Name: "LOWER", Args: []any{"col1"}},
+		},
+		{
+			name: "Upper",
+			got:  Upper("col1"),
+			want: inexpr.Function{Name: "UPPER", Args: []any{"col1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestExcluded(t *testing.T) {
+	assert.Equal(t, inexpr.Excluded{Column: "qty"}, Excluded("qty"))
+}
+
+func TestWhen(t *testing.T) {
+	cond := incondition.SimpleCondition{}
+	want := inexpr.Case{Whens: []inexpr.CaseWhen{{Condition: cond, Result: inexpr.Value{Value: 1}}}}
+	assert.Equal(t, want, When(cond, 1))
+}
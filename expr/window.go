@@ -0,0 +1,95 @@
+package expr
+
+import (
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+var (
+	// UnboundedPreceding bounds a window frame at the first row of the partition
+	UnboundedPreceding = inexpr.FrameBound{Kind: "UNBOUNDED PRECEDING"}
+	// CurrentRow bounds a window frame at the current row
+	CurrentRow = inexpr.FrameBound{Kind: "CURRENT ROW"}
+	// UnboundedFollowing bounds a window frame at the last row of the partition
+	UnboundedFollowing = inexpr.FrameBound{Kind: "UNBOUNDED FOLLOWING"}
+)
+
+// Preceding bounds a window frame at `offset` rows, or values when using RANGE, before the current row
+func Preceding(offset uint) inexpr.FrameBound {
+	return inexpr.FrameBound{Kind: "PRECEDING", Offset: offset}
+}
+
+// Following bounds a window frame at `offset` rows, or values when using RANGE, after the current row
+func Following(offset uint) inexpr.FrameBound {
+	return inexpr.FrameBound{Kind: "FOLLOWING", Offset: offset}
+}
+
+// Window returns an empty window definition, which includes every row of the result set.
+// Partitions, orderings and frames can be added to it using its methods.
+//
+// For example:
+//
+//	expr.Sum("amount").Over(
+//	    expr.Window().Partition("account_id").
+//	        Order(types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingAscending}).
+//	        Rows(expr.UnboundedPreceding, expr.CurrentRow),
+//	).As("balance")
+//
+// Will result in `SUM("amount") OVER (PARTITION BY "account_id" ORDER BY "created_at" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS "balance"`.
+func Window() inexpr.Window {
+	return inexpr.Window{}
+}
+
+// NamedWindow returns a window that refers to a window defined in the "WINDOW" clause of the query.
+// Any partitions, orderings or frames added to it will build upon the named window.
+func NamedWindow(name string) inexpr.Window {
+	return inexpr.Window{Name: name}
+}
+
+// PartitionBy returns a window that divides the rows into partitions by the provided columns or expressions
+func PartitionBy(column any, moreColumns ...any) inexpr.Window {
+	return Window().Partition(column, moreColumns...)
+}
+
+// OrderBy returns a window that sorts its rows by the provided orderings
+func OrderBy(ordering types.ColumnOrdering, moreOrderings ...types.ColumnOrdering) inexpr.Window {
+	orderings := make([]intypes.Ordering, len(moreOrderings))
+	for i, mo := range moreOrderings {
+		orderings[i] = mo
+	}
+	return Window().Order(ordering, orderings...)
+}
+
+// RowNumber returns an expression that numbers each row of its window, starting at 1
+//
+// For example:
+//
+//	expr.RowNumber().Over(expr.PartitionBy("department")).As("n")
+//
+// Will result in `ROW_NUMBER() OVER (PARTITION BY "department") AS "n"`.
+func RowNumber() inexpr.Function {
+	return Func("ROW_NUMBER")
+}
+
+// Rank returns an expression that ranks each row of its window, with gaps for rows that are tied
+func Rank() inexpr.Function {
+	return Func("RANK")
+}
+
+// DenseRank returns an expression that ranks each row of its window, without gaps for rows that are tied
+func DenseRank() inexpr.Function {
+	return Func("DENSE_RANK")
+}
+
+// Lag returns an expression that evaluates the argument at the row that comes before the current row of its window.
+// An offset and a default value can optionally be provided within `moreArgs`.
+func Lag(arg any, moreArgs ...any) inexpr.Function {
+	return Func("LAG", append([]any{arg}, moreArgs...)...)
+}
+
+// Lead returns an expression that evaluates the argument at the row that comes after the current row of its window.
+// An offset and a default value can optionally be provided within `moreArgs`.
+func Lead(arg any, moreArgs ...any) inexpr.Function {
+	return Func("LEAD", append([]any{arg}, moreArgs...)...)
+}
@@ -0,0 +1,102 @@
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+func TestFrameBounds(t *testing.T) {
+	assert.Equal(t, inexpr.FrameBound{Kind: "PRECEDING", Offset: 2}, Preceding(2))
+	assert.Equal(t, inexpr.FrameBound{Kind: "FOLLOWING", Offset: 5}, Following(5))
+}
+
+func TestWindows(t *testing.T) {
+	ordering1 := types.ColumnOrdering{ColumnName: "col1", Ordering: types.OrderingAscending}
+	ordering2 := types.ColumnOrdering{ColumnName: "col2", Ordering: types.OrderingDescending}
+
+	tests := []struct {
+		name string
+		got  inexpr.Window
+		want inexpr.Window
+	}{
+		{
+			name: "Window",
+			got:  Window(),
+			want: inexpr.Window{},
+		},
+		{
+			name: "NamedWindow",
+			got:  NamedWindow("w"),
+			want: inexpr.Window{Name: "w"},
+		},
+		{
+			name: "PartitionBy",
+			got:  PartitionBy("col1", "col2"),
+			want: inexpr.Window{PartitionBy: []any{"col1", "col2"}},
+		},
+		{
+			name: "OrderBy",
+			got:  OrderBy(ordering1, ordering2),
+			want: inexpr.Window{OrderBy: []intypes.Ordering{ordering1, ordering2}},
+		},
+		{
+			name: "Frame",
+			got:  Window().Rows(UnboundedPreceding, CurrentRow),
+			want: inexpr.Window{
+				Frame: &inexpr.Frame{
+					Unit:  "ROWS",
+					Start: inexpr.FrameBound{Kind: "UNBOUNDED PRECEDING"},
+					End:   inexpr.FrameBound{Kind: "CURRENT ROW"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestWindowFunctions(t *testing.T) {
+	tests := []struct {
+		name string
+		got  inexpr.Function
+		want inexpr.Function
+	}{
+		{
+			name: "RowNumber",
+			got:  RowNumber(),
+			want: inexpr.Function{Name: "ROW_NUMBER"},
+		},
+		{
+			name: "Rank",
+			got:  Rank(),
+			want: inexpr.Function{Name: "RANK"},
+		},
+		{
+			name: "DenseRank",
+			got:  DenseRank(),
+			want: inexpr.Function{Name: "DENSE_RANK"},
+		},
+		{
+			name: "Lag",
+			got:  Lag("price"),
+			want: inexpr.Function{Name: "LAG", Args: []any{"price"}},
+		},
+		{
+			name: "Lead",
+			got:  Lead("price", 2, 0),
+			want: inexpr.Function{Name: "LEAD", Args: []any{"price", 2, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package inbuilders
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
 	"github.com/williabk198/jagsqlb/internal/utilities/parsers"
 	"github.com/williabk198/jagsqlb/types"
 )
@@ -19,93 +23,392 @@ var (
 type orderByBuilder struct {
 	precedingBuilder builders.Builder
 	columnOrderings  []types.ColumnOrdering
+	dialect          dialect.Dialect
+	errs             intypes.ErrorSlice
 }
 
 func (obb orderByBuilder) Build() (string, []any, error) {
-	query, params, err := obb.precedingBuilder.Build()
-	if err != nil {
-		return "", nil, err
+	return finalizeBuild(obb.dialect, obb)
+}
+
+func (obb orderByBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, obb)
+}
+
+func (obb orderByBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, obb)
+}
+
+func (obb orderByBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, obb)
+}
+
+func (obb orderByBuilder) BuildRaw() (string, []any, error) {
+	if len(obb.errs) > 0 {
+		return "", nil, obb.errs
 	}
 
-	sb := new(strings.Builder)
-	ordering, err := obb.columnOrderings[0].Stringify()
+	query, params, err := buildRaw(obb.precedingBuilder)
 	if err != nil {
 		return "", nil, err
 	}
-	sb.WriteString(ordering)
 
-	for i := 1; i < len(obb.columnOrderings); i++ {
-		sb.WriteString(", ")
-		ordering, err := obb.columnOrderings[i].Stringify()
+	d := dialectOrDefault(obb.dialect)
+	sb := new(strings.Builder)
+
+	var orderingParams []any
+	for i, columnOrdering := range obb.columnOrderings {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		ordering, exprParams, err := columnOrdering.Parameterize(d)
 		if err != nil {
 			return "", nil, err
 		}
 		sb.WriteString(ordering)
+		orderingParams = append(orderingParams, exprParams...)
+	}
+
+	if err := obb.validateDistinctOn(d); err != nil {
+		return "", nil, err
 	}
 
 	query = fmt.Sprintf("%s ORDER BY %s;", query[:len(query)-1], sb.String())
-	return query, params, nil
+	return query, append(params, orderingParams...), nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (obb orderByBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(obb.dialect)
+}
+
+// validateDistinctOn checks that the "DISTINCT ON" expressions of the statement being ordered, if there are any,
+// match the leftmost "ORDER BY" expressions. Otherwise, the database would reject the query.
+func (obb orderByBuilder) validateDistinctOn(d dialect.Dialect) error {
+	distinctOn := distinctOnOf(obb.precedingBuilder)
+	if len(distinctOn) == 0 {
+		return nil
+	}
+
+	leading := make(map[string]bool, len(distinctOn))
+	for _, ordering := range obb.columnOrderings[:min(len(distinctOn), len(obb.columnOrderings))] {
+		orderingStr, orderingParams, err := ordering.ParameterizeColumn(d)
+		if err != nil {
+			return err
+		}
+		leading[fmt.Sprint(orderingStr, orderingParams)] = true
+	}
+
+	for _, expr := range distinctOn {
+		exprStr, exprParams, err := expr.Parameterize(d)
+		if err != nil {
+			return fmt.Errorf("failed to render DISTINCT ON expression: %w", err)
+		}
+		if !leading[fmt.Sprint(exprStr, exprParams)] {
+			return fmt.Errorf(
+				"the DISTINCT ON expressions must match the leftmost ORDER BY expressions; %s is not one of the first %d orderings",
+				exprStr, len(distinctOn),
+			)
+		}
+	}
+
+	return nil
+}
+
+// distinctOnOf returns the "DISTINCT ON" expressions of the SELECT statement that `b` is a part of
+func distinctOnOf(b builders.Builder) []intypes.Expression {
+	switch b := b.(type) {
+	case selectBuilder:
+		return b.distinctOn
+	case joinBuilder:
+		return b.selectBuilder.distinctOn
+	case selectWhereBuilder:
+		return distinctOnOf(b.mainQuery)
+	case groupByBuilder:
+		return distinctOnOf(b.precedingBuilder)
+	case havingBuilder:
+		return distinctOnOf(b.mainQuery)
+	case windowBuilder:
+		return distinctOnOf(b.precedingBuilder)
+	default:
+		return nil
+	}
+}
+
+func (obb orderByBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(obb.dialect, obb)
+}
+
+func (obb orderByBuilder) countRows() (builders.ExecutableBuilder, bool) {
+	if len(obb.errs) > 0 {
+		return nil, false
+	}
+
+	// The total number of rows is counted, rather than the number of rows after the page that `SeekAfter` seeks past
+	precedingBuilder := obb.precedingBuilder
+	if w, ok := precedingBuilder.(selectWhereBuilder); ok {
+		precedingBuilder = w.withoutSeek()
+	}
+	return newCountBuilder(obb.dialect, precedingBuilder), true
 }
 
 func (oob orderByBuilder) Offset(offset uint) builders.LimitBuilder {
 	return offsetBuilder{
 		precedingBuilder: oob,
 		offset:           offset,
+		dialect:          oob.dialect,
 	}
 }
 
-func (oob orderByBuilder) Limit(limit uint) builders.Builder {
+func (oob orderByBuilder) Limit(limit uint) builders.LockableBuilder {
 	return limitBuilder{
 		precedingBuilder: oob,
 		limit:            limit,
+		dialect:          oob.dialect,
 	}
 }
 
+func (obb orderByBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(obb.dialect, obb).ForUpdate(tables...)
+}
+
+func (obb orderByBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(obb.dialect, obb).ForNoKeyUpdate(tables...)
+}
+
+func (obb orderByBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(obb.dialect, obb).ForShare(tables...)
+}
+
+func (obb orderByBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(obb.dialect, obb).ForKeyShare(tables...)
+}
+
 type offsetBuilder struct {
 	precedingBuilder builders.Builder
 	offset           uint
+	dialect          dialect.Dialect
 }
 
 func (ob offsetBuilder) Build() (string, []any, error) {
-	query, params, err := ob.precedingBuilder.Build()
+	return finalizeBuild(ob.dialect, ob)
+}
+
+func (ob offsetBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, ob)
+}
+
+func (ob offsetBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, ob)
+}
+
+func (ob offsetBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, ob)
+}
+
+func (ob offsetBuilder) BuildRaw() (string, []any, error) {
+	query, params, err := buildRaw(ob.precedingBuilder)
+	if err != nil {
+		return "", nil, err
+	}
+
+	_, ordered := ob.precedingBuilder.(orderByBuilder)
+	pagination, err := paginationClause(dialectOrDefault(ob.dialect), nil, &ob.offset, ordered)
 	if err != nil {
 		return "", nil, err
 	}
 
-	query = fmt.Sprintf("%s OFFSET %d;", query[:len(query)-1], ob.offset)
+	query = fmt.Sprintf("%s %s;", query[:len(query)-1], pagination)
 	return query, params, nil
 }
 
-func (ob offsetBuilder) Limit(limit uint) builders.Builder {
+// Dialect implements intypes.RawBuilder.
+func (ob offsetBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(ob.dialect)
+}
+
+func (ob offsetBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(ob.dialect, ob)
+}
+
+func (ob offsetBuilder) countRows() (builders.ExecutableBuilder, bool) {
+	return newCountBuilder(ob.dialect, ob.precedingBuilder), true
+}
+
+func (ob offsetBuilder) Limit(limit uint) builders.LockableBuilder {
 	return limitBuilder{
 		precedingBuilder: ob,
 		limit:            limit,
+		dialect:          ob.dialect,
 	}
 }
 
+func (ob offsetBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(ob.dialect, ob).ForUpdate(tables...)
+}
+
+func (ob offsetBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(ob.dialect, ob).ForNoKeyUpdate(tables...)
+}
+
+func (ob offsetBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(ob.dialect, ob).ForShare(tables...)
+}
+
+func (ob offsetBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(ob.dialect, ob).ForKeyShare(tables...)
+}
+
 type limitBuilder struct {
 	precedingBuilder builders.Builder
 	limit            uint
+	dialect          dialect.Dialect
 }
 
 func (lb limitBuilder) Build() (string, []any, error) {
-	query, params, err := lb.precedingBuilder.Build()
+	return finalizeBuild(lb.dialect, lb)
+}
+
+func (lb limitBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, lb)
+}
+
+func (lb limitBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, lb)
+}
+
+func (lb limitBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, lb)
+}
+
+func (lb limitBuilder) BuildRaw() (string, []any, error) {
+	var offset *uint
+	precedingBuilder := lb.precedingBuilder
+
+	// If an offset was defined before this limit, then both need to be rendered together
+	// since not every dialect allows for "OFFSET" to come before "LIMIT"
+	if ob, ok := precedingBuilder.(offsetBuilder); ok {
+		offset = &ob.offset
+		precedingBuilder = ob.precedingBuilder
+	}
+
+	query, params, err := buildRaw(precedingBuilder)
+	if err != nil {
+		return "", nil, err
+	}
+
+	_, ordered := precedingBuilder.(orderByBuilder)
+	pagination, err := paginationClause(dialectOrDefault(lb.dialect), &lb.limit, offset, ordered)
 	if err != nil {
 		return "", nil, err
 	}
 
-	query = fmt.Sprintf("%s LIMIT %d;", query[:len(query)-1], lb.limit)
+	query = fmt.Sprintf("%s %s;", query[:len(query)-1], pagination)
 	return query, params, nil
 }
 
-// finalizeQuery replaces any "?" characters in the provided query with "$n" characters
-func finalizeQuery(query string, existingParams int) string {
+// Dialect implements intypes.RawBuilder.
+func (lb limitBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(lb.dialect)
+}
+
+func (lb limitBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(lb.dialect, lb)
+}
+
+func (lb limitBuilder) countRows() (builders.ExecutableBuilder, bool) {
+	return newCountBuilder(lb.dialect, lb.precedingBuilder), true
+}
+
+func (lb limitBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(lb.dialect, lb).ForUpdate(tables...)
+}
+
+func (lb limitBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(lb.dialect, lb).ForNoKeyUpdate(tables...)
+}
+
+func (lb limitBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(lb.dialect, lb).ForShare(tables...)
+}
+
+func (lb limitBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(lb.dialect, lb).ForKeyShare(tables...)
+}
+
+// paginationClause returns the clause that limits and/or offsets the result set in the form that the provided dialect expects.
+// A nil value for `limit` or `offset` indicates that the respective value was not provided, and `ordered` indicates
+// whether the query being paginated already ends with an "ORDER BY" clause.
+func paginationClause(d dialect.Dialect, limit, offset *uint, ordered bool) (string, error) {
+	if d.Supports(dialect.ClauseOffsetFetch) {
+		var offsetVal uint
+		if offset != nil {
+			offsetVal = *offset
+		}
+
+		clause := fmt.Sprintf("OFFSET %d ROWS", offsetVal)
+		// "OFFSET ... FETCH" is part of the "ORDER BY" clause, so an ordering that keeps the existing order is used when
+		// none was given
+		if !ordered {
+			clause = "ORDER BY (SELECT NULL) " + clause
+		}
+		if limit != nil {
+			clause += fmt.Sprintf(" FETCH NEXT %d ROWS ONLY", *limit)
+		}
+		return clause, nil
+	}
+
+	if limit == nil {
+		if !d.Supports(dialect.ClauseOffsetWithoutLimit) {
+			return "", intypes.NewUnsupportedClauseError(d, dialect.ClauseOffsetWithoutLimit)
+		}
+		return fmt.Sprintf("OFFSET %d", *offset), nil
+	}
+
+	if offset == nil {
+		return fmt.Sprintf("LIMIT %d", *limit), nil
+	}
+	return fmt.Sprintf("LIMIT %d OFFSET %d", *limit, *offset), nil
+}
+
+// buildRaw builds the provided builder, which precedes another in the same chain, with "?" in place of each of its
+// parameters so that further clauses can be appended to it
+func buildRaw(b builders.Builder) (string, []any, error) {
+	rb, ok := b.(intypes.RawBuilder)
+	if !ok {
+		return "", nil, fmt.Errorf("a query built by %T cannot be extended", b)
+	}
+	return rb.BuildRaw()
+}
+
+// finalizeBuild builds the raw query of the provided builder and replaces each "?" within it with the placeholders of
+// the provided dialect. Since the query is finalized only once, every parameter is numbered in the order that it appears.
+func finalizeBuild(d dialect.Dialect, rb intypes.RawBuilder) (string, []any, error) {
+	query, params, err := rb.BuildRaw()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return finalizeQuery(dialectOrDefault(d), query), params, nil
+}
+
+// finalizeQuery replaces any "?" characters in the provided query with the placeholders of the provided dialect
+func finalizeQuery(d dialect.Dialect, query string) string {
 	pattern := regexp.MustCompile(`\?`)
-	count := existingParams
+	count := 0
 	result := pattern.ReplaceAllStringFunc(query, func(value string) string {
 		count++
-		return fmt.Sprintf("$%d", count)
+		return d.Placeholder(count)
 	})
 
 	return result
 }
+
+// dialectOrDefault returns the provided dialect. If it is nil, then PostgreSQL is returned instead
+func dialectOrDefault(d dialect.Dialect) dialect.Dialect {
+	if d == nil {
+		return dialect.Postgres
+	}
+	return d
+}
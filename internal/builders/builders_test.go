@@ -5,6 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/expr"
 	"github.com/williabk198/jagsqlb/types"
 )
 
@@ -23,7 +26,7 @@ func Test_orderByBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Single Asecending",
 			obb: orderByBuilder{
-				precedingBuilder: NewSelectBuilder("table1", "*"),
+				precedingBuilder: NewSelectBuilder(nil, "table1", "*"),
 				columnOrderings: []types.ColumnOrdering{
 					{ColumnName: "column1", Ordering: types.OrderingAscending},
 				},
@@ -36,7 +39,7 @@ func Test_orderByBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Single Descending",
 			obb: orderByBuilder{
-				precedingBuilder: NewSelectBuilder("table1", "*"),
+				precedingBuilder: NewSelectBuilder(nil, "table1", "*"),
 				columnOrderings: []types.ColumnOrdering{
 					{ColumnName: "column1", Ordering: types.OrderingDescending},
 				},
@@ -49,7 +52,7 @@ func Test_orderByBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Multiple Mixed",
 			obb: orderByBuilder{
-				precedingBuilder: NewSelectBuilder("table1", "*"),
+				precedingBuilder: NewSelectBuilder(nil, "table1", "*"),
 				columnOrderings: []types.ColumnOrdering{
 					{ColumnName: "column1", Ordering: types.OrderingAscending},
 					{ColumnName: "column2", Ordering: types.OrderingDescending},
@@ -60,17 +63,65 @@ func Test_orderByBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; Expression After Where",
+			obb: orderByBuilder{
+				precedingBuilder: NewSelectBuilder(nil, "table1", "*").Where(condition.Equals("col1", "test")),
+				columnOrderings: []types.ColumnOrdering{
+					{Expression: expr.Coalesce("column2", 0), Ordering: types.OrderingDescending},
+					{ColumnName: "column1", Ordering: types.OrderingAscending},
+				},
+			},
+			wants: wants{
+				query:  `SELECT * FROM "table1" WHERE "col1" = $1 ORDER BY COALESCE("column2", $2) DESC, "column1" ASC;`,
+				params: []any{"test", 0},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Case Expression, Alias and Position",
+			obb: orderByBuilder{
+				precedingBuilder: NewSelectBuilder(nil, "tickets", "title", expr.Lower("owner").As("owner_name")).
+					Where(condition.Equals("open", true)),
+				columnOrderings: []types.ColumnOrdering{
+					types.Asc(expr.When(condition.Equals("priority", "urgent"), 0).Else(1)),
+					types.Asc("owner_name").Collate("C"),
+					types.Desc(1),
+				},
+			},
+			wants: wants{
+				query: `SELECT "title", LOWER("owner") AS "owner_name" FROM "tickets" WHERE "open" = $1 ` +
+					`ORDER BY CASE WHEN "priority" = $2 THEN $3 ELSE $4 END ASC, "owner_name" COLLATE "C" ASC, 1 DESC;`,
+				params: []any{true, "urgent", 0, 1},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Emulated Nulls",
+			obb: orderByBuilder{
+				precedingBuilder: NewSelectBuilder(dialect.SQLServer, "users", "*"),
+				columnOrderings: []types.ColumnOrdering{
+					types.Desc(expr.Lower("nickname")).NullsLast(),
+					types.Asc("id"),
+				},
+				dialect: dialect.SQLServer,
+			},
+			wants: wants{
+				query: `SELECT * FROM [users] ORDER BY CASE WHEN LOWER([nickname]) IS NULL THEN 1 ELSE 0 END, LOWER([nickname]) DESC, [id] ASC;`,
+			},
+			assertion: assert.NoError,
+		},
 		{
 			name: "Error; Preceding Builder",
 			obb: orderByBuilder{
-				precedingBuilder: NewSelectBuilder(".t1 AS", "col1"),
+				precedingBuilder: NewSelectBuilder(nil, ".t1 AS", "col1"),
 			},
 			assertion: assert.Error,
 		},
 		{
 			name: "Error; Bad Column in Ordering",
 			obb: orderByBuilder{
-				precedingBuilder: NewSelectBuilder("table1 AS t1", "*"),
+				precedingBuilder: NewSelectBuilder(nil, "table1 AS t1", "*"),
 				columnOrderings: []types.ColumnOrdering{
 					{ColumnName: ".col1", Ordering: types.OrderingDescending},
 				},
@@ -94,7 +145,7 @@ func Test_orderByBuilder_Offset(t *testing.T) {
 	}
 
 	testOrderBuilder := orderByBuilder{
-		precedingBuilder: NewSelectBuilder("table1", "*"),
+		precedingBuilder: NewSelectBuilder(nil, "table1", "*"),
 		columnOrderings: []types.ColumnOrdering{
 			{ColumnName: "col1", Ordering: types.OrderingAscending},
 		},
@@ -131,7 +182,7 @@ func Test_orderByBuilder_Limit(t *testing.T) {
 	}
 
 	testOrderBuilder := orderByBuilder{
-		precedingBuilder: NewSelectBuilder("table1", "*"),
+		precedingBuilder: NewSelectBuilder(nil, "table1", "*"),
 		columnOrderings: []types.ColumnOrdering{
 			{ColumnName: "col1", Ordering: types.OrderingAscending},
 		},
@@ -177,7 +228,7 @@ func Test_offsetBuilder_Build(t *testing.T) {
 		{
 			name: "Success",
 			ob: offsetBuilder{
-				precedingBuilder: NewSelectBuilder("table1", "*"),
+				precedingBuilder: NewSelectBuilder(nil, "table1", "*"),
 				offset:           100,
 			},
 			wants: wants{
@@ -185,6 +236,39 @@ func Test_offsetBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; SQL Server",
+			ob: offsetBuilder{
+				precedingBuilder: NewSelectBuilder(dialect.SQLServer, "table1", "*"),
+				offset:           100,
+				dialect:          dialect.SQLServer,
+			},
+			wants: wants{
+				query: `SELECT * FROM [table1] ORDER BY (SELECT NULL) OFFSET 100 ROWS;`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQL Server w/ Order By",
+			ob: offsetBuilder{
+				precedingBuilder: NewSelectBuilder(dialect.SQLServer, "table1", "*").OrderBy(types.Asc("col1")),
+				offset:           100,
+				dialect:          dialect.SQLServer,
+			},
+			wants: wants{
+				query: `SELECT * FROM [table1] ORDER BY [col1] ASC OFFSET 100 ROWS;`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Unsupported by Dialect",
+			ob: offsetBuilder{
+				precedingBuilder: NewSelectBuilder(dialect.MySQL, "table1", "*"),
+				offset:           100,
+				dialect:          dialect.MySQL,
+			},
+			assertion: assert.Error,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -202,7 +286,7 @@ func Test_offsetBuilder_Limit(t *testing.T) {
 	}
 
 	testOffsetBuilder := offsetBuilder{
-		precedingBuilder: NewSelectBuilder("table1", "*"),
+		precedingBuilder: NewSelectBuilder(nil, "table1", "*"),
 		offset:           50,
 	}
 
@@ -246,7 +330,7 @@ func Test_limitBuilder_Build(t *testing.T) {
 		{
 			name: "Success",
 			lb: limitBuilder{
-				precedingBuilder: NewSelectBuilder("table1", "col1"),
+				precedingBuilder: NewSelectBuilder(nil, "table1", "col1"),
 				limit:            25,
 			},
 			wants: wants{
@@ -254,6 +338,60 @@ func Test_limitBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; w/ Offset",
+			lb: limitBuilder{
+				precedingBuilder: offsetBuilder{
+					precedingBuilder: NewSelectBuilder(nil, "table1", "col1"),
+					offset:           50,
+				},
+				limit: 25,
+			},
+			wants: wants{
+				query: `SELECT "col1" FROM "table1" LIMIT 25 OFFSET 50;`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL w/ Offset",
+			lb: limitBuilder{
+				precedingBuilder: offsetBuilder{
+					precedingBuilder: NewSelectBuilder(dialect.MySQL, "table1", "col1"),
+					offset:           50,
+					dialect:          dialect.MySQL,
+				},
+				limit:   25,
+				dialect: dialect.MySQL,
+			},
+			wants: wants{
+				query: "SELECT `col1` FROM `table1` LIMIT 25 OFFSET 50;",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQL Server",
+			lb: limitBuilder{
+				precedingBuilder: NewSelectBuilder(dialect.SQLServer, "table1", "col1"),
+				limit:            25,
+				dialect:          dialect.SQLServer,
+			},
+			wants: wants{
+				query: `SELECT [col1] FROM [table1] ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 25 ROWS ONLY;`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQL Server w/ Order By and Offset",
+			lb: limitBuilder{
+				precedingBuilder: NewSelectBuilder(dialect.SQLServer, "table1", "col1").OrderBy(types.Desc("col1")).Offset(50),
+				limit:            25,
+				dialect:          dialect.SQLServer,
+			},
+			wants: wants{
+				query: `SELECT [col1] FROM [table1] ORDER BY [col1] DESC OFFSET 50 ROWS FETCH NEXT 25 ROWS ONLY;`,
+			},
+			assertion: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,209 @@
+package inbuilders
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+const (
+	compoundUnion     = "UNION"
+	compoundUnionAll  = "UNION ALL"
+	compoundIntersect = "INTERSECT"
+	compoundExcept    = "EXCEPT"
+)
+
+// compoundOperand is a query that is combined with the queries preceding it using `operator`
+type compoundOperand struct {
+	operator string
+	query    builders.Builder
+}
+
+// compoundBuilder implements `builders.CompoundBuilder` and represents multiple SELECT queries that are combined
+// using UNION, INTERSECT or EXCEPT
+type compoundBuilder struct {
+	firstQuery builders.Builder
+	operands   []compoundOperand
+	dialect    dialect.Dialect
+	errs       intypes.ErrorSlice
+}
+
+func (cb compoundBuilder) Build() (string, []any, error) {
+	return finalizeBuild(cb.dialect, cb)
+}
+
+func (cb compoundBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, cb)
+}
+
+func (cb compoundBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, cb)
+}
+
+func (cb compoundBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, cb)
+}
+
+func (cb compoundBuilder) BuildRaw() (string, []any, error) {
+	if len(cb.errs) > 0 {
+		return "", nil, cb.errs
+	}
+
+	d := dialectOrDefault(cb.dialect)
+	query, params, err := intypes.BuildNested(d, cb.firstQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build first query of compound query: %w", err)
+	}
+
+	sb := new(strings.Builder)
+	sb.WriteString(query[:len(query)-1])
+
+	for _, operand := range cb.operands {
+		operandQuery, operandParams, err := intypes.BuildNested(d, operand.query)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to build %s query: %w", operand.operator, err)
+		}
+		operandQuery = operandQuery[:len(operandQuery)-1]
+
+		sb.WriteRune(' ')
+		sb.WriteString(operand.operator)
+		sb.WriteRune(' ')
+
+		// Without parentheses, the ordering and pagination of the operand would apply to the entire compound query, and
+		// a compound operand would be combined with the queries preceding it instead of being evaluated on its own
+		parenthesize, err := parenthesizeOperand(d, operand.query)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid %s query: %w", operand.operator, err)
+		}
+		if parenthesize {
+			sb.WriteRune('(')
+			sb.WriteString(operandQuery)
+			sb.WriteRune(')')
+		} else {
+			sb.WriteString(operandQuery)
+		}
+		params = append(params, operandParams...)
+	}
+	sb.WriteRune(';')
+
+	return sb.String(), params, nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (cb compoundBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(cb.dialect)
+}
+
+func (cb compoundBuilder) Union(query builders.Builder) builders.CompoundBuilder {
+	return cb.combine(compoundUnion, query)
+}
+
+func (cb compoundBuilder) UnionAll(query builders.Builder) builders.CompoundBuilder {
+	return cb.combine(compoundUnionAll, query)
+}
+
+func (cb compoundBuilder) Intersect(query builders.Builder) builders.CompoundBuilder {
+	return cb.combine(compoundIntersect, query)
+}
+
+func (cb compoundBuilder) Except(query builders.Builder) builders.CompoundBuilder {
+	return cb.combine(compoundExcept, query)
+}
+
+func (cb compoundBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(cb.dialect, cb)
+}
+
+func (cb compoundBuilder) Limit(limit uint) builders.LockableBuilder {
+	return limitBuilder{
+		precedingBuilder: cb,
+		limit:            limit,
+		dialect:          cb.dialect,
+	}
+}
+
+func (cb compoundBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(cb.dialect, cb).ForUpdate(tables...)
+}
+
+func (cb compoundBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(cb.dialect, cb).ForNoKeyUpdate(tables...)
+}
+
+func (cb compoundBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(cb.dialect, cb).ForShare(tables...)
+}
+
+func (cb compoundBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(cb.dialect, cb).ForKeyShare(tables...)
+}
+
+func (cb compoundBuilder) Offset(offset uint) builders.LimitBuilder {
+	return offsetBuilder{
+		precedingBuilder: cb,
+		offset:           offset,
+		dialect:          cb.dialect,
+	}
+}
+
+func (cb compoundBuilder) OrderBy(ordering types.ColumnOrdering, moreOrderings ...types.ColumnOrdering) builders.OffsetBuilder {
+	return orderByBuilder{
+		precedingBuilder: cb,
+		columnOrderings:  append([]types.ColumnOrdering{ordering}, moreOrderings...),
+		dialect:          cb.dialect,
+	}
+}
+
+// combine adds `query` to the compound query using the provided operator
+func (cb compoundBuilder) combine(operator string, query builders.Builder) compoundBuilder {
+	if query == nil {
+		cb.errs = append(slices.Clone(cb.errs), fmt.Errorf("no query was provided for %s", operator))
+		return cb
+	}
+
+	// Copy the operands so that appending to them doesn't affect any other builder sharing the same backing array
+	cb.operands = append(append([]compoundOperand(nil), cb.operands...), compoundOperand{operator: operator, query: query})
+	return cb
+}
+
+// newCompoundBuilder creates a compoundBuilder that combines `firstQuery` with `query` using the provided operator
+func newCompoundBuilder(d dialect.Dialect, firstQuery builders.Builder, operator string, query builders.Builder) compoundBuilder {
+	cb := compoundBuilder{
+		firstQuery: firstQuery,
+		dialect:    d,
+	}
+	return cb.combine(operator, query)
+}
+
+// parenthesizeOperand reports whether the provided query needs to be wrapped in parentheses when it is used as an
+// operand of a compound query. An error is returned if the dialect doesn't allow the query to be used as an operand.
+func parenthesizeOperand(d dialect.Dialect, query builders.Builder) (bool, error) {
+	// A WITH clause can only begin a statement, so the operand must be parenthesized for it to begin its own statement
+	hasWith := len(withOf(query)) > 0
+	switch query.(type) {
+	case compoundBuilder, offsetBuilder, limitBuilder:
+	case orderByBuilder:
+		if !d.Supports(dialect.ClauseNestedOrderBy) {
+			return false, intypes.NewUnsupportedClauseError(d, dialect.ClauseNestedOrderBy)
+		}
+	default:
+		if !hasWith {
+			return false, nil
+		}
+	}
+
+	if hasWith && !d.Supports(dialect.ClauseNestedWith) {
+		return false, intypes.NewUnsupportedClauseError(d, dialect.ClauseNestedWith)
+	}
+	if !d.Supports(dialect.ClauseParenthesizedQuery) {
+		return false, intypes.NewUnsupportedClauseError(d, dialect.ClauseParenthesizedQuery)
+	}
+	return true, nil
+}
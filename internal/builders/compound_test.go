@@ -0,0 +1,219 @@
+package inbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/join"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+func Test_compoundBuilder_Build(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Success; Union",
+			builder: NewSelectBuilder(nil, "customers", "email").Union(NewSelectBuilder(nil, "suppliers", "email")),
+			wants: wants{
+				query: `SELECT "email" FROM "customers" UNION SELECT "email" FROM "suppliers";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Every Operator w/ Params",
+			builder: NewSelectBuilder(nil, "table1", "col1").Where(condition.Equals("col2", 1)).
+				UnionAll(NewSelectBuilder(nil, "table2", "col1").Where(condition.Equals("col2", 2))).
+				Intersect(NewSelectBuilder(nil, "table3", "col1").Where(condition.Equals("col2", 3))).
+				Except(NewSelectBuilder(nil, "table4", "col1").Where(condition.Equals("col2", 4))).
+				Union(NewSelectBuilder(nil, "table5", "col1")),
+			wants: wants{
+				query: `SELECT "col1" FROM "table1" WHERE "col2" = $1 ` +
+					`UNION ALL SELECT "col1" FROM "table2" WHERE "col2" = $2 ` +
+					`INTERSECT SELECT "col1" FROM "table3" WHERE "col2" = $3 ` +
+					`EXCEPT SELECT "col1" FROM "table4" WHERE "col2" = $4 ` +
+					`UNION SELECT "col1" FROM "table5";`,
+				params: []any{1, 2, 3, 4},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Order By and Limit on Compound",
+			builder: NewSelectBuilder(nil, "customers", "email").Where(condition.Equals("active", true)).
+				Union(NewSelectBuilder(nil, "suppliers", "email").Where(condition.Equals("active", true))).
+				OrderBy(types.ColumnOrdering{ColumnName: "email", Ordering: types.OrderingAscending}).
+				Offset(20).
+				Limit(10),
+			wants: wants{
+				query: `SELECT "email" FROM "customers" WHERE "active" = $1 UNION SELECT "email" FROM "suppliers" WHERE "active" = $2 ` +
+					`ORDER BY "email" ASC LIMIT 10 OFFSET 20;`,
+				params: []any{true, true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Joins and Grouping",
+			builder: NewSelectBuilder(nil, "orders AS o", "customer_id").
+				Join(join.TypeInner, "customers AS c", join.On(condition.Equals("o.customer_id", condition.ColumnValue("c.id")))).
+				Where(condition.GreaterThan("o.total", 100)).
+				Except(
+					NewSelectBuilder(nil, "refunds", "customer_id").GroupBy("customer_id").Having(condition.GreaterThan("customer_id", 5)),
+				),
+			wants: wants{
+				query: `SELECT "o"."customer_id" FROM "orders" AS "o" INNER JOIN "customers" AS "c" ON "o"."customer_id" = "c"."id" WHERE "o"."total" > $1 ` +
+					`EXCEPT SELECT "customer_id" FROM "refunds" GROUP BY "customer_id" HAVING "customer_id" > $2;`,
+				params: []any{100, 5},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Parenthesized Operands",
+			builder: NewSelectBuilder(nil, "table1", "col1").
+				Union(NewSelectBuilder(nil, "table2", "col1").OrderBy(types.ColumnOrdering{ColumnName: "col1", Ordering: types.OrderingDescending}).Limit(5)).
+				Union(NewSelectBuilder(nil, "table3", "col1").Intersect(NewSelectBuilder(nil, "table4", "col1"))),
+			wants: wants{
+				query: `SELECT "col1" FROM "table1" ` +
+					`UNION (SELECT "col1" FROM "table2" ORDER BY "col1" DESC LIMIT 5) ` +
+					`UNION (SELECT "col1" FROM "table3" INTERSECT SELECT "col1" FROM "table4");`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL",
+			builder: NewSelectBuilder(dialect.MySQL, "table1", "col1").Where(condition.Equals("col2", 1)).
+				UnionAll(NewSelectBuilder(dialect.MySQL, "table2", "col1").Where(condition.Equals("col2", 2))).
+				Limit(10),
+			wants: wants{
+				query:  "SELECT `col1` FROM `table1` WHERE `col2` = ? UNION ALL SELECT `col1` FROM `table2` WHERE `col2` = ? LIMIT 10;",
+				params: []any{1, 2},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQL Server",
+			builder: NewSelectBuilder(dialect.SQLServer, "table1", "col1").Where(condition.Equals("col2", 1)).
+				Except(NewSelectBuilder(dialect.SQLServer, "table2", "col1").Where(condition.Equals("col2", 2))),
+			wants: wants{
+				query:  `SELECT [col1] FROM [table1] WHERE [col2] = @p1 EXCEPT SELECT [col1] FROM [table2] WHERE [col2] = @p2;`,
+				params: []any{1, 2},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQL Server Paginated Operand",
+			builder: NewSelectBuilder(dialect.SQLServer, "table1", "col1").
+				Union(NewSelectBuilder(dialect.SQLServer, "table2", "col1").OrderBy(types.ColumnOrdering{ColumnName: "col1", Ordering: types.OrderingDescending}).Limit(5)),
+			wants: wants{
+				query: `SELECT [col1] FROM [table1] UNION (SELECT [col1] FROM [table2] ORDER BY [col1] DESC OFFSET 0 ROWS FETCH NEXT 5 ROWS ONLY);`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL Parenthesized Operand",
+			builder: NewSelectBuilder(dialect.MySQL, "table1", "col1").
+				Union(NewSelectBuilder(dialect.MySQL, "table2", "col1").Limit(5)),
+			wants: wants{
+				query: "SELECT `col1` FROM `table1` UNION (SELECT `col1` FROM `table2` LIMIT 5);",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; SQLite Paginated Operand",
+			builder: NewSelectBuilder(dialect.SQLite, "table1", "col1").
+				Union(NewSelectBuilder(dialect.SQLite, "table2", "col1").Limit(1)),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; SQLite Compound Operand",
+			builder: NewSelectBuilder(dialect.SQLite, "table1", "col1").
+				Union(NewSelectBuilder(dialect.SQLite, "table2", "col1").Intersect(NewSelectBuilder(dialect.SQLite, "table3", "col1"))),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; SQL Server Ordered Operand",
+			builder: NewSelectBuilder(dialect.SQLServer, "table1", "col1").
+				Union(NewSelectBuilder(dialect.SQLServer, "table2", "col1").OrderBy(types.ColumnOrdering{ColumnName: "col1", Ordering: types.OrderingDescending})),
+			assertion: assert.Error,
+		},
+		{
+			name: "Success; Operand w/ Common Table Expression",
+			builder: NewSelectBuilder(nil, "table1", "col1").
+				Union(NewWithBuilder(nil).With("recent", NewSelectBuilder(nil, "table2", "col1").Where(condition.Equals("col2", 2))).
+					Select("recent", "col1")),
+			wants: wants{
+				query:  `SELECT "col1" FROM "table1" UNION (WITH "recent" AS (SELECT "col1" FROM "table2" WHERE "col2" = $1) SELECT "col1" FROM "recent");`,
+				params: []any{2},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; SQL Server Operand w/ Common Table Expression",
+			builder: NewSelectBuilder(dialect.SQLServer, "table1", "col1").
+				Union(NewWithBuilder(dialect.SQLServer).With("recent", NewSelectBuilder(dialect.SQLServer, "table2", "col1")).
+					Select("recent", "col1")),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; SQLite Operand w/ Common Table Expression",
+			builder: NewSelectBuilder(dialect.SQLite, "table1", "col1").
+				Except(NewWithBuilder(dialect.SQLite).With("recent", NewSelectBuilder(dialect.SQLite, "table2", "col1")).
+					Select("recent", "col1").Where(condition.Equals("col1", 1))),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Missing Query",
+			builder:   NewSelectBuilder(nil, "table1", "col1").Union(nil),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; First Query",
+			builder:   NewSelectBuilder(nil, ".table1", "col1").Union(NewSelectBuilder(nil, "table2", "col1")),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Operand Query",
+			builder:   NewSelectBuilder(nil, "table1", "col1").Union(NewSelectBuilder(nil, ".table2", "col1")),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Operand w/ Different Dialect",
+			builder:   NewSelectBuilder(dialect.SQLServer, "table1", "col1").Union(NewSelectBuilder(nil, "table2", "col1")),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func Test_compoundBuilder_combine(t *testing.T) {
+	base := NewSelectBuilder(nil, "table1", "col1").Union(NewSelectBuilder(nil, "table2", "col1"))
+
+	// Combining the same compound query multiple times should not cause the results to affect each other
+	withTable3 := base.Union(NewSelectBuilder(nil, "table3", "col1"))
+	withTable4 := base.Except(NewSelectBuilder(nil, "table4", "col1"))
+
+	gotQuery, _, err := withTable3.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT "col1" FROM "table1" UNION SELECT "col1" FROM "table2" UNION SELECT "col1" FROM "table3";`, gotQuery)
+
+	gotQuery, _, err = withTable4.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT "col1" FROM "table1" UNION SELECT "col1" FROM "table2" EXCEPT SELECT "col1" FROM "table4";`, gotQuery)
+}
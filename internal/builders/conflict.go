@@ -0,0 +1,291 @@
+package inbuilders
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
+	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/internal/utilities/parsers"
+)
+
+// conflictBuilder implements `builders.ConflictActionBuilder` and `builders.ConflictUpdateBuilder`, and represents
+// the handling of conflicting rows in an INSERT statement
+type conflictBuilder struct {
+	insert        insertBuilder
+	targetColumns []intypes.Column
+	constraint    string
+	update        bool
+	setColumns    []intypes.Column
+	setValues     []any
+	conditions    whereConditions
+	dialect       dialect.Dialect
+	errs          intypes.ErrorSlice
+}
+
+func (cb conflictBuilder) Build() (string, []any, error) {
+	return finalizeBuild(cb.dialect, cb)
+}
+
+func (cb conflictBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, cb)
+}
+
+func (cb conflictBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, cb)
+}
+
+func (cb conflictBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, cb)
+}
+
+func (cb conflictBuilder) BuildRaw() (string, []any, error) {
+	if len(cb.errs) > 0 {
+		return "", nil, cb.errs
+	}
+
+	query, params, err := cb.insert.BuildRaw()
+	if err != nil {
+		return "", nil, err
+	}
+
+	d := dialectOrDefault(cb.dialect)
+	var clause string
+	var clauseParams []any
+	switch {
+	case d.Supports(dialect.ClauseOnConflict):
+		clause, clauseParams, err = cb.onConflictClause(d)
+	case d.Supports(dialect.ClauseOnDuplicateKey):
+		clause, clauseParams, err = cb.onDuplicateKeyClause(d)
+	default:
+		err = intypes.NewUnsupportedClauseError(d, dialect.ClauseOnConflict)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	query = fmt.Sprintf("%s %s;", query[:len(query)-1], clause)
+	return query, append(params, clauseParams...), nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (cb conflictBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(cb.dialect)
+}
+
+// onConflictClause renders the "ON CONFLICT" clause used by PostgreSQL and SQLite
+func (cb conflictBuilder) onConflictClause(d dialect.Dialect) (string, []any, error) {
+	sb := new(strings.Builder)
+	sb.WriteString("ON CONFLICT")
+
+	if cb.constraint != "" {
+		if !d.Supports(dialect.ClauseOnConstraint) {
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseOnConstraint)
+		}
+		sb.WriteString(" ON CONSTRAINT ")
+		sb.WriteString(d.QuoteIdentifier(cb.constraint))
+	} else if len(cb.targetColumns) > 0 {
+		sb.WriteString(" (")
+		for i, column := range cb.targetColumns {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(column.Render(d))
+		}
+		sb.WriteRune(')')
+	}
+
+	if !cb.update {
+		sb.WriteString(" DO NOTHING")
+		return sb.String(), nil, nil
+	}
+
+	if cb.constraint == "" && len(cb.targetColumns) == 0 {
+		return "", nil, fmt.Errorf("a conflict target must be provided in order to update conflicting rows")
+	}
+
+	assignments, params, err := cb.assignments(d)
+	if err != nil {
+		return "", nil, err
+	}
+	sb.WriteString(" DO UPDATE SET ")
+	sb.WriteString(assignments)
+
+	if !cb.conditions.isEmpty() {
+		condStr, condParams, err := cb.conditions.parameterize(d)
+		if err != nil {
+			return "", nil, err
+		}
+		sb.WriteString(" WHERE ")
+		sb.WriteString(condStr)
+		params = append(params, condParams...)
+	}
+
+	return sb.String(), params, nil
+}
+
+// onDuplicateKeyClause renders the "ON DUPLICATE KEY UPDATE" clause used by MySQL
+func (cb conflictBuilder) onDuplicateKeyClause(d dialect.Dialect) (string, []any, error) {
+	if cb.constraint != "" {
+		return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseOnConstraint)
+	}
+	if len(cb.conditions) > 0 {
+		return "", nil, fmt.Errorf("unsupported clause error: conditions on %s are not supported by the %s dialect", dialect.ClauseOnDuplicateKey, d.Name())
+	}
+
+	if !cb.update {
+		// There is no "DO NOTHING" equivalent, so assign one of the inserted columns to itself to leave the row unchanged
+		if len(cb.insert.columns) == 0 {
+			return "", nil, fmt.Errorf("at least one column must be inserted in order to ignore conflicting rows with the %s dialect", d.Name())
+		}
+		column := cb.insert.columns[0].Render(d)
+		return fmt.Sprintf("ON DUPLICATE KEY UPDATE %s = %s", column, column), nil, nil
+	}
+
+	assignments, params, err := cb.assignments(d)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "ON DUPLICATE KEY UPDATE " + assignments, params, nil
+}
+
+// assignments renders each of the columns that will be updated along with their values
+func (cb conflictBuilder) assignments(d dialect.Dialect) (string, []any, error) {
+	sb := new(strings.Builder)
+	var params []any
+
+	for i, column := range cb.setColumns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(column.Render(d))
+		sb.WriteString(" = ")
+
+		value, valueParams, err := assignmentValue(cb.setValues[i]).Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to render value of %s: %w", column.Render(d), err)
+		}
+		sb.WriteString(value)
+		params = append(params, valueParams...)
+	}
+
+	return sb.String(), params, nil
+}
+
+func (cb conflictBuilder) DoNothing() builders.ReturningBuilder {
+	cb.update = false
+	return cb
+}
+
+func (cb conflictBuilder) DoUpdateSet(data any) builders.ConflictUpdateBuilder {
+	cb.update = true
+
+	var cols []string
+	var vals []any
+	if colValMap, ok := data.(map[string]any); ok {
+		// Sort the columns so that the query is the same each time that it's built
+		cols = make([]string, 0, len(colValMap))
+		for col := range colValMap {
+			cols = append(cols, col)
+		}
+		slices.Sort(cols)
+
+		for _, col := range cols {
+			vals = append(vals, colValMap[col])
+		}
+	} else {
+		var err error
+		cols, vals, err = parsers.ParseColumnTag(intypes.QueryTypeUpdate, data)
+		if err != nil {
+			cb.errs = append(slices.Clone(cb.errs), fmt.Errorf("failed to process argument of DoUpdateSet: %w", err))
+			return cb
+		}
+	}
+
+	if len(cols) == 0 {
+		cb.errs = append(slices.Clone(cb.errs), fmt.Errorf("no columns were provided to DoUpdateSet"))
+		return cb
+	}
+
+	for _, col := range cols {
+		colData, err := columnParser.Parse(col)
+		if err != nil {
+			cb.errs = append(slices.Clone(cb.errs), err)
+			return cb
+		}
+		cb.setColumns = append(cb.setColumns, colData)
+	}
+	cb.setValues = vals
+
+	return cb
+}
+
+func (cb conflictBuilder) Where(cond incondition.Condition, moreConds ...incondition.Condition) builders.ReturningWhereBuilder {
+	cb.conditions = whereConditions{{condition: cond}}
+
+	if len(moreConds) > 0 {
+		return cb.And(moreConds[0], moreConds[1:]...)
+	}
+
+	return cb
+}
+
+func (cb conflictBuilder) And(cond incondition.Condition, additionalConds ...incondition.Condition) builders.ReturningWhereBuilder {
+	cb.conditions = cb.appendConditions("AND", cond, additionalConds...)
+	return cb
+}
+
+func (cb conflictBuilder) Or(cond incondition.Condition, additionalConds ...incondition.Condition) builders.ReturningWhereBuilder {
+	cb.conditions = cb.appendConditions("OR", cond, additionalConds...)
+	return cb
+}
+
+func (cb conflictBuilder) Returning(column string, moreColumns ...string) builders.ExecutableBuilder {
+	rb := returningBuilder{
+		prevBuilder: cb,
+		dialect:     cb.dialect,
+	}
+	return rb.Returning(column, moreColumns...)
+}
+
+// appendConditions returns a copy of the conflict action's conditions with the provided conditions appended using `conjunction`
+func (cb conflictBuilder) appendConditions(conjunction string, cond incondition.Condition, additionalConds ...incondition.Condition) whereConditions {
+	conditions := slices.Clone(cb.conditions)
+	for _, c := range append([]incondition.Condition{cond}, additionalConds...) {
+		conditions.Append(whereCondition{
+			conjunction: conjunction,
+			condition:   c,
+		})
+	}
+	return conditions
+}
+
+// newConflictBuilder creates a conflictBuilder that handles the conflicting rows of the provided insert statement
+func newConflictBuilder(ib insertBuilder) conflictBuilder {
+	return conflictBuilder{
+		insert:  ib,
+		dialect: ib.dialect,
+	}
+}
+
+// assignmentValue converts a value being assigned to a column into an expression. Column values and expressions are
+// rendered as is, while any other value is bound as a query parameter.
+func assignmentValue(value any) intypes.Expression {
+	switch v := value.(type) {
+	case incondition.ColumnValue:
+		return inexpr.Column{Name: v.ColumnName}
+	case intypes.Ordering:
+		return inexpr.Invalid{Err: intypes.ErrMisplacedOrdering}
+	case intypes.Expression:
+		return v
+	default:
+		return inexpr.Value{Value: v}
+	}
+}
@@ -0,0 +1,243 @@
+package inbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/expr"
+)
+
+func Test_conflictBuilder_Build(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	type stock struct {
+		Quantity int    `jagsqlb:"qty"`
+		Location string `jagsqlb:"location"`
+		SKU      string `jagsqlb:"sku;omit-update"`
+	}
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Do Nothing",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoNothing(),
+			wants: wants{
+				query:  `INSERT INTO "stock" ("sku", "qty") VALUES ($1, $2) ON CONFLICT ("sku") DO NOTHING;`,
+				params: []any{"abc", 5},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Do Nothing w/o Target",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).OnConflict().DoNothing(),
+			wants: wants{
+				query:  `INSERT INTO "stock" ("sku", "qty") VALUES ($1, $2) ON CONFLICT DO NOTHING;`,
+				params: []any{"abc", 5},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Do Update Set Map",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "location", "qty").Values([]any{"abc", "north", 5}).
+				OnConflict("sku", "location").DoUpdateSet(map[string]any{
+				"qty":        expr.Excluded("qty"),
+				"updated_by": "importer",
+			}),
+			wants: wants{
+				query: `INSERT INTO "stock" ("sku", "location", "qty") VALUES ($1, $2, $3) ` +
+					`ON CONFLICT ("sku", "location") DO UPDATE SET "qty" = EXCLUDED."qty", "updated_by" = $4;`,
+				params: []any{"abc", "north", 5, "importer"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Do Update Set Struct",
+			builder: NewInsertBuilder(nil, "stock").Data(stock{Quantity: 5, Location: "north", SKU: "abc"}).
+				OnConflict("sku").DoUpdateSet(stock{Quantity: 5, Location: "north"}),
+			wants: wants{
+				query: `INSERT INTO "stock" ("qty", "location", "sku") VALUES ($1, $2, $3) ` +
+					`ON CONFLICT ("sku") DO UPDATE SET "qty" = $4, "location" = $5;`,
+				params: []any{5, "north", "abc", 5, "north"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; On Constraint w/ Where and Returning",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConstraint("stock_sku_key").
+				DoUpdateSet(map[string]any{"qty": expr.Excluded("qty")}).
+				Where(condition.LessThan("stock.qty", expr.Excluded("qty"))).
+				Or(condition.Equals("stock.locked", false)).
+				Returning("qty"),
+			wants: wants{
+				query: `INSERT INTO "stock" ("sku", "qty") VALUES ($1, $2) ON CONFLICT ON CONSTRAINT "stock_sku_key" ` +
+					`DO UPDATE SET "qty" = EXCLUDED."qty" WHERE "stock"."qty" < EXCLUDED."qty" OR "stock"."locked" = $3 RETURNING "qty";`,
+				params: []any{"abc", 5, false},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Column Value",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoUpdateSet(map[string]any{"previous_qty": condition.ColumnValue("stock.qty")}).
+				Returning("*"),
+			wants: wants{
+				query: `INSERT INTO "stock" ("sku", "qty") VALUES ($1, $2) ` +
+					`ON CONFLICT ("sku") DO UPDATE SET "previous_qty" = "stock"."qty" RETURNING *;`,
+				params: []any{"abc", 5},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; With Common Table Expression",
+			builder: NewWithBuilder(nil).With("incoming", NewSelectBuilder(nil, "staging", "sku").Where(condition.Equals("batch", 7))).
+				Insert("stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoUpdateSet(map[string]any{"qty": 0}),
+			wants: wants{
+				query: `WITH "incoming" AS (SELECT "sku" FROM "staging" WHERE "batch" = $1) INSERT INTO "stock" ("sku", "qty") VALUES ($2, $3) ` +
+					`ON CONFLICT ("sku") DO UPDATE SET "qty" = $4;`,
+				params: []any{7, "abc", 5, 0},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQLite",
+			builder: NewInsertBuilder(dialect.SQLite, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoUpdateSet(map[string]any{"qty": expr.Excluded("qty")}).
+				Returning("qty"),
+			wants: wants{
+				query:  `INSERT INTO "stock" ("sku", "qty") VALUES (?, ?) ON CONFLICT ("sku") DO UPDATE SET "qty" = EXCLUDED."qty" RETURNING "qty";`,
+				params: []any{"abc", 5},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL Do Update Set",
+			builder: NewInsertBuilder(dialect.MySQL, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoUpdateSet(map[string]any{"qty": expr.Excluded("qty"), "updated_by": "importer"}),
+			wants: wants{
+				query:  "INSERT INTO `stock` (`sku`, `qty`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `qty` = VALUES(`qty`), `updated_by` = ?;",
+				params: []any{"abc", 5, "importer"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL Do Update Set w/ Excluded Expression",
+			builder: NewInsertBuilder(dialect.MySQL, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict().DoUpdateSet(map[string]any{"qty": expr.Col("qty").Plus(expr.Excluded("qty"))}),
+			wants: wants{
+				query:  "INSERT INTO `stock` (`sku`, `qty`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `qty` = `qty` + VALUES(`qty`);",
+				params: []any{"abc", 5},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL Do Nothing",
+			builder: NewInsertBuilder(dialect.MySQL, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict().DoNothing(),
+			wants: wants{
+				query:  "INSERT INTO `stock` (`sku`, `qty`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `sku` = `sku`;",
+				params: []any{"abc", 5},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Do Update Set w/o Target",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict().DoUpdateSet(map[string]any{"qty": 0}),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Do Update Set w/o Columns",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoUpdateSet(map[string]any{}),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Do Update Set Bad Argument",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoUpdateSet(42),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Bad Target Column",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict(".sku").DoNothing(),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Empty Constraint",
+			builder: NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConstraint("").DoNothing(),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Insert Statement",
+			builder: NewInsertBuilder(nil, ".stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoNothing(),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; SQLite On Constraint",
+			builder: NewInsertBuilder(dialect.SQLite, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConstraint("stock_sku_key").DoNothing(),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; MySQL Where",
+			builder: NewInsertBuilder(dialect.MySQL, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoUpdateSet(map[string]any{"qty": 0}).Where(condition.Equals("locked", false)),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; MySQL Do Nothing w/o Columns",
+			builder: NewInsertBuilder(dialect.MySQL, "stock").DefaultValues().
+				OnConflict().DoNothing(),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; SQL Server",
+			builder: NewInsertBuilder(dialect.SQLServer, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+				OnConflict("sku").DoNothing(),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func Test_conflictBuilder_And(t *testing.T) {
+	base := NewInsertBuilder(nil, "stock").Columns("sku", "qty").Values([]any{"abc", 5}).
+		OnConflict("sku").DoUpdateSet(map[string]any{"qty": 0}).Where(condition.Equals("locked", false))
+
+	// Adding conditions to the same builder multiple times should not cause the results to affect each other
+	withAnd := base.And(condition.GreaterThan("qty", 1))
+	withOr := base.Or(condition.IsNull("qty"))
+
+	gotQuery, gotParams, err := withAnd.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "stock" ("sku", "qty") VALUES ($1, $2) ON CONFLICT ("sku") DO UPDATE SET "qty" = $3 WHERE "locked" = $4 AND "qty" > $5;`, gotQuery)
+	assert.Equal(t, []any{"abc", 5, 0, false, 1}, gotParams)
+
+	gotQuery, gotParams, err = withOr.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "stock" ("sku", "qty") VALUES ($1, $2) ON CONFLICT ("sku") DO UPDATE SET "qty" = $3 WHERE "locked" = $4 OR "qty" IS NULL;`, gotQuery)
+	assert.Equal(t, []any{"abc", 5, 0, false}, gotParams)
+}
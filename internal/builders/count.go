@@ -0,0 +1,79 @@
+package inbuilders
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+// countSubqueryAlias is the alias given to a query when it is wrapped in a subquery in order to count its rows
+const countSubqueryAlias = "count_query"
+
+// countColumn is the column that replaces the selected columns of a query when counting its rows
+var countColumn = intypes.SelectColumn{Expression: inexpr.Function{Name: "COUNT", Args: []any{"*"}}}
+
+// countable is implemented by the parts of a SELECT statement that can derive a query which counts the rows they return
+// without needing to wrap themselves in a subquery
+type countable interface {
+	// countRows returns the query that counts the rows returned by the statement, without any of its ordering, pagination
+	// or row locks. If the rows can't be counted without wrapping the statement in a subquery, then false is returned.
+	countRows() (builders.ExecutableBuilder, bool)
+}
+
+// countBuilder implements `builders.ExecutableBuilder` and counts the rows returned by a query that can't have its selected
+// columns replaced, such as a query with a GROUP BY clause or a compound query, by wrapping it in a subquery
+type countBuilder struct {
+	query   builders.Builder
+	dialect dialect.Dialect
+}
+
+func (cb countBuilder) Build() (string, []any, error) {
+	return finalizeBuild(cb.dialect, cb)
+}
+
+func (cb countBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, cb)
+}
+
+func (cb countBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, cb)
+}
+
+func (cb countBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, cb)
+}
+
+func (cb countBuilder) BuildRaw() (string, []any, error) {
+	query, params, err := buildRaw(cb.query)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build query to count: %w", err)
+	}
+
+	d := dialectOrDefault(cb.dialect)
+	return fmt.Sprintf("SELECT COUNT(*) FROM (%s) AS %s;", query[:len(query)-1], d.QuoteIdentifier(countSubqueryAlias)), params, nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (cb countBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(cb.dialect)
+}
+
+// newCountBuilder returns the query that counts the rows returned by `query`. If `query` can't be counted directly,
+// then it is wrapped in a subquery.
+func newCountBuilder(d dialect.Dialect, query builders.Builder) builders.ExecutableBuilder {
+	if c, ok := query.(countable); ok {
+		if counted, ok := c.countRows(); ok {
+			return counted
+		}
+	}
+
+	return countBuilder{
+		query:   query,
+		dialect: d,
+	}
+}
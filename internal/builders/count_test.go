@@ -0,0 +1,192 @@
+package inbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	"github.com/williabk198/jagsqlb/join"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+func Test_CountQuery(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	createdDesc := types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingDescending}
+	idAsc := types.ColumnOrdering{ColumnName: "id", Ordering: types.OrderingAscending}
+
+	tests := []struct {
+		name      string
+		builder   builders.CountBuilders
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Success; Select",
+			builder: NewSelectBuilder(nil, "posts", "id", "title"),
+			wants: wants{
+				query: `SELECT COUNT(*) FROM "posts";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Where w/ Pagination",
+			builder: NewSelectBuilder(nil, "posts", "*").Where(condition.Equals("author_id", 7)).
+				Or(condition.Equals("featured", true)).OrderBy(createdDesc).Offset(40).Limit(20),
+			wants: wants{
+				query:  `SELECT COUNT(*) FROM "posts" WHERE "author_id" = $1 OR "featured" = $2;`,
+				params: []any{7, true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Join",
+			builder: NewSelectBuilder(nil, "posts AS p", "*").
+				Join(join.TypeInner, "users AS u", join.On(condition.Equals("u.id", condition.ColumnValue("p.user_id"))), "name").
+				Where(condition.Equals("u.active", true)).OrderBy(createdDesc).Limit(10),
+			wants: wants{
+				query:  `SELECT COUNT(*) FROM "posts" AS "p" INNER JOIN "users" AS "u" ON "u"."id" = "p"."user_id" WHERE "u"."active" = $1;`,
+				params: []any{true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Parameterized Columns",
+			builder: NewSelectBuilder(nil, "products", inexpr.Function{Name: "COALESCE", Args: []any{"price", 0}}).
+				Where(condition.GreaterThan("stock", 5)),
+			wants: wants{
+				query:  `SELECT COUNT(*) FROM "products" WHERE "stock" > $1;`,
+				params: []any{5},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Seek After",
+			builder: NewSelectBuilder(nil, "posts", "*").Where(condition.Equals("author_id", 7)).
+				SeekAfter([]types.ColumnOrdering{idAsc}, []any{42}).Limit(20),
+			wants: wants{
+				query:  `SELECT COUNT(*) FROM "posts" WHERE "author_id" = $1;`,
+				params: []any{7},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Seek After w/ Or",
+			builder: NewSelectBuilder(nil, "posts", "*").Where(condition.Equals("author_id", 7)).Or(condition.Equals("featured", true)).
+				SeekAfter([]types.ColumnOrdering{createdDesc, idAsc}, []any{"2025-01-01", 42}),
+			wants: wants{
+				query:  `SELECT COUNT(*) FROM "posts" WHERE ("author_id" = $1 OR "featured" = $2);`,
+				params: []any{7, true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Seek After Select",
+			builder: NewSelectBuilder(nil, "posts", "*").
+				SeekAfter([]types.ColumnOrdering{idAsc}, []any{42}).Limit(20),
+			wants: wants{
+				query: `SELECT COUNT(*) FROM "posts";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Group By",
+			builder: NewSelectBuilder(nil, "orders", "customer_id").Where(condition.Equals("status", "paid")).
+				GroupBy("customer_id").Having(condition.GreaterThan(inexpr.Function{Name: "SUM", Args: []any{"total"}}, 100)).
+				OrderBy(idAsc).Limit(5),
+			wants: wants{
+				query: `SELECT COUNT(*) FROM (SELECT "customer_id" FROM "orders" WHERE "status" = $1 GROUP BY "customer_id" ` +
+					`HAVING SUM("total") > $2) AS "count_query";`,
+				params: []any{"paid", 100},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Window",
+			builder: NewSelectBuilder(nil, "employees", "name").
+				Window("w", inexpr.Window{PartitionBy: []any{"department"}}).OrderBy(idAsc),
+			wants: wants{
+				query: `SELECT COUNT(*) FROM "employees";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Compound",
+			builder: NewSelectBuilder(nil, "customers", "email").
+				Union(NewSelectBuilder(nil, "suppliers", "email").Where(condition.Equals("active", true))).Limit(10),
+			wants: wants{
+				query:  `SELECT COUNT(*) FROM (SELECT "email" FROM "customers" UNION SELECT "email" FROM "suppliers" WHERE "active" = $1) AS "count_query";`,
+				params: []any{true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Row Lock",
+			builder: NewSelectBuilder(nil, "jobs", "*").Where(condition.Equals("status", "pending")).Limit(1).ForUpdate().SkipLocked(),
+			wants: wants{
+				query:  `SELECT COUNT(*) FROM "jobs" WHERE "status" = $1;`,
+				params: []any{"pending"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQL Server Group By",
+			builder: NewSelectBuilder(dialect.SQLServer, "orders", "customer_id").Where(condition.Equals("status", "paid")).
+				GroupBy("customer_id").OrderBy(idAsc).Offset(10).Limit(5),
+			wants: wants{
+				query:  `SELECT COUNT(*) FROM (SELECT [customer_id] FROM [orders] WHERE [status] = @p1 GROUP BY [customer_id]) AS [count_query];`,
+				params: []any{"paid"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Distinct",
+			builder: NewSelectBuilder(nil, "customers", "city").Distinct().Where(condition.Equals("active", true)).Limit(10),
+			wants: wants{
+				query:  `SELECT COUNT(*) FROM (SELECT DISTINCT "city" FROM "customers" WHERE "active" = $1) AS "count_query";`,
+				params: []any{true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Distinct On w/ Join",
+			builder: NewSelectBuilder(nil, "orders AS o", "*").DistinctOn("o.customer_id").
+				Join(join.TypeInner, "customers AS c", join.Using("customer_id")).
+				OrderBy(types.ColumnOrdering{ColumnName: "o.customer_id", Ordering: types.OrderingAscending}),
+			wants: wants{
+				query: `SELECT COUNT(*) FROM (SELECT DISTINCT ON ("o"."customer_id") "o".* FROM "orders" AS "o" ` +
+					`INNER JOIN "customers" AS "c" USING ("customer_id")) AS "count_query";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Bad Table",
+			builder:   NewSelectBuilder(nil, ".posts", "*").OrderBy(idAsc),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Row Lock",
+			builder:   NewSelectBuilder(nil, "jobs", "*").ForUpdate(".jobs"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Group By",
+			builder:   NewSelectBuilder(nil, "orders", "customer_id").GroupBy(".customer_id"),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := builders.ToCount(tt.builder).Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
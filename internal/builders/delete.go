@@ -1,22 +1,63 @@
 package inbuilders
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	injoin "github.com/williabk198/jagsqlb/internal/join"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
 type deleteBuilder struct {
 	table       string
 	usingTables []intypes.Table
+	fromTables  []intypes.Table
+	joins       []joinCondition
+	with        withClause
+	dialect     dialect.Dialect
 	errs        intypes.ErrorSlice
 }
 
 // Build implements builders.DeleteBuilder.
 func (d deleteBuilder) Build() (query string, queryParams []any, err error) {
+	return finalizeBuild(d.dialect, d)
+}
+
+func (d deleteBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, d)
+}
+
+func (d deleteBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, d)
+}
+
+func (d deleteBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, d)
+}
+
+// BuildRaw implements intypes.RawBuilder.
+func (d deleteBuilder) BuildRaw() (query string, queryParams []any, err error) {
+	query, queryParams, err = d.buildStatement()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return d.with.prefix(dialectOrDefault(d.dialect), query, queryParams)
+}
+
+// Dialect implements intypes.RawBuilder.
+func (d deleteBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(d.dialect)
+}
+
+// buildStatement builds the DELETE statement without any of its preceding common table expressions
+func (d deleteBuilder) buildStatement() (query string, queryParams []any, err error) {
 	if len(d.errs) > 0 {
 		return "", nil, d.errs
 	}
@@ -26,36 +67,95 @@ func (d deleteBuilder) Build() (query string, queryParams []any, err error) {
 		return "", nil, fmt.Errorf("failed to parse table data in base delete builder: %w", err)
 	}
 
+	dia := dialectOrDefault(d.dialect)
 	sb := new(strings.Builder)
-	sb.WriteString("DELETE FROM ")
-	sb.WriteString(table.String())
+	sb.WriteString("DELETE ")
+
+	if len(d.fromTables) > 0 || len(d.joins) > 0 {
+		if !dia.Supports(dialect.ClauseDeleteJoin) {
+			return "", nil, intypes.NewUnsupportedClauseError(dia, dialect.ClauseDeleteJoin)
+		}
+
+		// If no tables were provided to delete from, then the table being deleted from is also the one that is joined onto
+		fromTables := d.fromTables
+		if len(fromTables) == 0 {
+			fromTables = []intypes.Table{table}
+		}
+
+		sb.WriteString(table.RenderReference(dia))
+		sb.WriteString(" FROM ")
+		for i, fromTable := range fromTables {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(fromTable.Render(dia))
+		}
+
+		joinStr, joinParams, err := renderJoins(dia, d.joins)
+		if err != nil {
+			return "", nil, err
+		}
+		sb.WriteString(joinStr)
+		queryParams = joinParams
+	} else {
+		sb.WriteString("FROM ")
+		sb.WriteString(table.Render(dia))
+	}
 
 	if len(d.usingTables) == 0 {
 		sb.WriteRune(';')
-		return sb.String(), nil, nil
+		return sb.String(), queryParams, nil
+	}
+
+	if !dia.Supports(dialect.ClauseDeleteUsing) {
+		return "", nil, intypes.NewUnsupportedClauseError(dia, dialect.ClauseDeleteUsing)
 	}
 
 	sb.WriteString(" USING ")
-	sb.WriteString(d.usingTables[0].String())
+	sb.WriteString(d.usingTables[0].Render(dia))
 
 	for i := 1; i < len(d.usingTables); i++ {
 		sb.WriteString(", ")
-		sb.WriteString(d.usingTables[i].String())
+		sb.WriteString(d.usingTables[i].Render(dia))
 	}
 
 	sb.WriteRune(';')
-	return sb.String(), nil, nil
+	return sb.String(), queryParams, nil
+}
+
+// From implements builders.DeleteBuilder.
+func (d deleteBuilder) From(table string) builders.DeleteBuilder {
+	tableData, err := tableParser.Parse(table)
+	if err != nil {
+		d.errs = append(slices.Clone(d.errs), err)
+		return d
+	}
+
+	d.fromTables = append(slices.Clone(d.fromTables), tableData)
+	return d
+}
+
+// Join implements builders.DeleteBuilder.
+func (d deleteBuilder) Join(joinType injoin.Type, table string, joinRelation injoin.Relation) builders.DeleteBuilder {
+	joinCond, err := newJoinCondition(joinType, table, joinRelation)
+	if err != nil {
+		d.errs = append(slices.Clone(d.errs), err)
+		return d
+	}
+
+	d.joins = append(slices.Clone(d.joins), joinCond)
+	return d
 }
 
 // Using implements builders.DeleteBuilder.
 func (d deleteBuilder) Using(table string) builders.DeleteBuilder {
 	tableData, err := tableParser.Parse(table)
 	if err != nil {
-		d.errs = append(d.errs, err)
+		d.errs = append(slices.Clone(d.errs), err)
 		return d
 	}
 
-	d.usingTables = append(d.usingTables, tableData)
+	d.usingTables = append(slices.Clone(d.usingTables), tableData)
 	return d
 }
 
@@ -66,6 +166,7 @@ func (d deleteBuilder) Where(condition incondition.Condition, moreConditions ...
 		conditions: whereConditions{
 			{condition: condition},
 		},
+		dialect: d.dialect,
 	}
 
 	if len(moreConditions) > 0 {
@@ -76,15 +177,19 @@ func (d deleteBuilder) Where(condition incondition.Condition, moreConditions ...
 }
 
 // Returning implements builders.DeleteBuilder.
-func (d deleteBuilder) Returning(column string, moreColumns ...string) builders.Builder {
+func (d deleteBuilder) Returning(column string, moreColumns ...string) builders.ExecutableBuilder {
 	rb := returningBuilder{
 		prevBuilder: d,
+		dialect:     d.dialect,
 	}
 	return rb.Returning(column, moreColumns...)
 }
 
-func NewDeleteBuilder(table string) builders.DeleteBuilder {
+// NewDeleteBuilder creates a DeleteBuilder that renders its query using the provided dialect.
+// If `d` is nil, then PostgreSQL will be used.
+func NewDeleteBuilder(d dialect.Dialect, table string) builders.DeleteBuilder {
 	return deleteBuilder{
-		table: table,
+		table:   table,
+		dialect: d,
 	}
 }
@@ -7,8 +7,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/williabk198/jagsqlb/builders"
 	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/join"
 )
 
 func Test_deleteBuilder_Build(t *testing.T) {
@@ -47,6 +49,17 @@ func Test_deleteBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; MySQL",
+			d: deleteBuilder{
+				table:   "table1",
+				dialect: dialect.MySQL,
+			},
+			wants: wants{
+				query: "DELETE FROM `table1`;",
+			},
+			assertion: assert.NoError,
+		},
 		{
 			name: "Error; Bad Table Name",
 			d: deleteBuilder{
@@ -54,6 +67,15 @@ func Test_deleteBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.Error,
 		},
+		{
+			name: "Error; Using Unsupported by Dialect",
+			d: deleteBuilder{
+				table:       "table1",
+				usingTables: []intypes.Table{{Name: "table2"}},
+				dialect:     dialect.SQLite,
+			},
+			assertion: assert.Error,
+		},
 		{
 			name: "Error; ErrorSlice not empty",
 			d: deleteBuilder{
@@ -117,6 +139,77 @@ func Test_deleteBuilder_Using(t *testing.T) {
 	}
 }
 
+func Test_deleteBuilder_Join(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	onCustomer := join.On(
+		condition.Equals("o.customer_id", condition.ColumnValue("c.id")),
+		condition.Equals("c.region", "EU"),
+	)
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; From and Join",
+			builder: NewDeleteBuilder(dialect.MySQL, "o").From("orders o").
+				Join(join.TypeInner, "customers c", onCustomer).
+				Where(condition.Equals("c.active", false)),
+			wants: wants{
+				query: "DELETE `o` FROM `orders` AS `o` INNER JOIN `customers` AS `c` ON `o`.`customer_id` = `c`.`id` AND `c`.`region` = ? " +
+					"WHERE `c`.`active` = ?;",
+				params: []any{"EU", false},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Join w/o From",
+			builder: NewDeleteBuilder(dialect.MySQL, "orders AS o").Join(join.TypeLeft, "customers AS c", join.Using("customer_id")),
+			wants: wants{
+				query: "DELETE `o` FROM `orders` AS `o` LEFT JOIN `customers` AS `c` USING (`customer_id`);",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Multiple From Tables",
+			builder: NewDeleteBuilder(dialect.MySQL, "t1").From("t1").From("t2"),
+			wants: wants{
+				query: "DELETE `t1` FROM `t1`, `t2`;",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Unsupported by Dialect",
+			builder:   NewDeleteBuilder(nil, "o").From("orders o").Join(join.TypeInner, "customers c", onCustomer),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Join Table",
+			builder:   NewDeleteBuilder(dialect.MySQL, "orders").Join(join.TypeInner, ".customers", onCustomer),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad From Table",
+			builder:   NewDeleteBuilder(dialect.MySQL, "o").From("orders AS"),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
 func Test_deleteBuilder_Where(t *testing.T) {
 	type args struct {
 		condition      incondition.Condition
@@ -221,7 +314,23 @@ func TestNewDeleteBuilder(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, NewDeleteBuilder(tt.args.table))
+			assert.Equal(t, tt.want, NewDeleteBuilder(nil, tt.args.table))
 		})
 	}
 }
+
+func Test_deleteBuilder_Using_Branches(t *testing.T) {
+	// Three tables leave room in the slice of tables for a fourth without it needing to grow
+	base := NewDeleteBuilder(nil, "orders").Using("a").Using("b").Using("c")
+
+	first := base.Using("x")
+	second := base.Using("y")
+
+	gotQuery, _, err := first.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `DELETE FROM "orders" USING "a", "b", "c", "x";`, gotQuery)
+
+	gotQuery, _, err = second.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `DELETE FROM "orders" USING "a", "b", "c", "y";`, gotQuery)
+}
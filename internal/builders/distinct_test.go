@@ -0,0 +1,124 @@
+package inbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	"github.com/williabk198/jagsqlb/join"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+func Test_selectBuilder_Distinct(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	customerAsc := types.ColumnOrdering{ColumnName: "customer_id", Ordering: types.OrderingAscending}
+	createdDesc := types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingDescending}
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Success; Distinct",
+			builder: NewSelectBuilder(nil, "customers", "city").Distinct(),
+			wants: wants{
+				query: `SELECT DISTINCT "city" FROM "customers";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Distinct w/ Join",
+			builder: NewSelectBuilder(nil, "customers AS c", "city").Distinct().Join(join.TypeInner, "orders AS o", join.Using("customer_id")),
+			wants: wants{
+				query: `SELECT DISTINCT "c"."city" FROM "customers" AS "c" INNER JOIN "orders" AS "o" USING ("customer_id");`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Distinct Replaces Distinct On",
+			builder: NewSelectBuilder(dialect.MySQL, "customers", "city").DistinctOn("city").Distinct(),
+			wants: wants{
+				query: "SELECT DISTINCT `city` FROM `customers`;",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Distinct On",
+			builder: NewSelectBuilder(nil, "orders", "*").DistinctOn("customer_id").Where(condition.Equals("status", "paid")).
+				OrderBy(customerAsc, createdDesc),
+			wants: wants{
+				query:  `SELECT DISTINCT ON ("customer_id") * FROM "orders" WHERE "status" = $1 ORDER BY "customer_id" ASC, "created_at" DESC;`,
+				params: []any{"paid"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Distinct On Expression",
+			builder: NewSelectBuilder(nil, "users", "*").DistinctOn(inexpr.Function{Name: "COALESCE", Args: []any{"team_id", 0}}).
+				Where(condition.Equals("active", true)),
+			wants: wants{
+				query:  `SELECT DISTINCT ON (COALESCE("team_id", $1)) * FROM "users" WHERE "active" = $2;`,
+				params: []any{0, true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Distinct On w/ Join",
+			builder: NewSelectBuilder(nil, "orders AS o", "*").DistinctOn("o.customer_id", "o.region").
+				Join(join.TypeLeft, "customers AS c", join.On(condition.Equals("c.id", condition.ColumnValue("o.customer_id"))), "name").
+				OrderBy(
+					types.ColumnOrdering{ColumnName: "o.region", Ordering: types.OrderingAscending},
+					types.ColumnOrdering{ColumnName: "o.customer_id", Ordering: types.OrderingAscending},
+					types.ColumnOrdering{ColumnName: "o.created_at", Ordering: types.OrderingDescending},
+				),
+			wants: wants{
+				query: `SELECT DISTINCT ON ("o"."customer_id", "o"."region") "o".*, "c"."name" FROM "orders" AS "o" ` +
+					`LEFT JOIN "customers" AS "c" ON "c"."id" = "o"."customer_id" ` +
+					`ORDER BY "o"."region" ASC, "o"."customer_id" ASC, "o"."created_at" DESC;`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Distinct On Not Leading Order By",
+			builder:   NewSelectBuilder(nil, "orders", "*").DistinctOn("customer_id").OrderBy(createdDesc, customerAsc),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Distinct On Missing From Order By",
+			builder:   NewSelectBuilder(nil, "orders", "*").DistinctOn("customer_id", "region").Where(condition.Equals("status", "paid")).OrderBy(customerAsc),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Distinct On Unsupported",
+			builder:   NewSelectBuilder(dialect.MySQL, "orders", "*").DistinctOn("customer_id"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Distinct On Column",
+			builder:   NewSelectBuilder(nil, "orders", "*").DistinctOn(".customer_id"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Distinct On Column w/ Join",
+			builder:   NewSelectBuilder(nil, "orders", "*").DistinctOn(42).Join(join.TypeInner, "customers", join.Using("customer_id")),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
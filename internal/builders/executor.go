@@ -0,0 +1,117 @@
+package inbuilders
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/williabk198/jagsqlb/builders"
+	inscan "github.com/williabk198/jagsqlb/internal/scan"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+// row implements `builders.Row`
+type row struct {
+	rows  *sql.Rows
+	query string
+	err   error
+}
+
+func (r row) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	defer r.rows.Close()
+
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return &intypes.QueryError{Query: r.query, Err: err}
+		}
+		return &intypes.QueryError{Query: r.query, Err: sql.ErrNoRows}
+	}
+
+	if err := r.rows.Scan(dest...); err != nil {
+		return &intypes.QueryError{Query: r.query, Err: err}
+	}
+	if err := r.rows.Close(); err != nil {
+		return &intypes.QueryError{Query: r.query, Err: err}
+	}
+	return nil
+}
+
+func (r row) Err() error {
+	return r.err
+}
+
+// Exec builds the query of `b` and runs it using `db`
+func Exec(ctx context.Context, db builders.ExecerContext, b builders.Builder) (sql.Result, error) {
+	query, params, err := b.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := db.ExecContext(ctx, query, params...)
+	if err != nil {
+		return nil, &intypes.QueryError{Query: query, Err: err}
+	}
+	return result, nil
+}
+
+// Query builds the query of `b` and runs it using `db`, returning the rows of its result set
+func Query(ctx context.Context, db builders.QueryerContext, b builders.Builder) (*sql.Rows, error) {
+	rows, _, err := query(ctx, db, b)
+	return rows, err
+}
+
+// QueryRow builds the query of `b` and runs it using `db`, returning the first row of its result set
+func QueryRow(ctx context.Context, db builders.QueryerContext, b builders.Builder) builders.Row {
+	rows, query, err := query(ctx, db, b)
+	return row{
+		rows:  rows,
+		query: query,
+		err:   err,
+	}
+}
+
+// Get builds the query of `b`, runs it using `db` and reads the first row of its result set into a value of type T
+func Get[T any](ctx context.Context, db builders.QueryerContext, b builders.Builder) (T, error) {
+	rows, query, err := query(ctx, db, b)
+	if err != nil {
+		var result T
+		return result, err
+	}
+
+	result, err := inscan.One[T](rows)
+	if err != nil {
+		return result, &intypes.QueryError{Query: query, Err: err}
+	}
+	return result, nil
+}
+
+// Select builds the query of `b`, runs it using `db` and reads each row of its result set into a value of type T
+func Select[T any](ctx context.Context, db builders.QueryerContext, b builders.Builder) ([]T, error) {
+	rows, query, err := query(ctx, db, b)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := inscan.All[T](rows)
+	if err != nil {
+		return nil, &intypes.QueryError{Query: query, Err: err}
+	}
+	return results, nil
+}
+
+// query builds the query of `b` and runs it using `db`. The built query is returned along with the rows of its result set.
+func query(ctx context.Context, db builders.QueryerContext, b builders.Builder) (*sql.Rows, string, error) {
+	query, params, err := b.Build()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to build query: %w", err)
+	}
+
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, query, &intypes.QueryError{Query: query, Err: err}
+	}
+	return rows, query, nil
+}
@@ -0,0 +1,290 @@
+package inbuilders
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+// testDatabase is a minimal database driver that records the queries that it runs, and responds with a fixed result
+type testDatabase struct {
+	columns []string
+	values  [][]driver.Value
+	err     error
+
+	query  string
+	params []any
+}
+
+func (td *testDatabase) Connect(context.Context) (driver.Conn, error) {
+	return testConn{td}, nil
+}
+
+func (td *testDatabase) Driver() driver.Driver {
+	return nil
+}
+
+func (td *testDatabase) record(query string, args []driver.NamedValue) error {
+	td.query = query
+	td.params = nil
+	for _, arg := range args {
+		td.params = append(td.params, arg.Value)
+	}
+	return td.err
+}
+
+type testConn struct {
+	db *testDatabase
+}
+
+func (tc testConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (tc testConn) Close() error {
+	return nil
+}
+
+func (tc testConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (tc testConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := tc.db.record(query, args); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(len(tc.db.values)), nil
+}
+
+func (tc testConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := tc.db.record(query, args); err != nil {
+		return nil, err
+	}
+	return &testDriverRows{columns: tc.db.columns, values: tc.db.values}, nil
+}
+
+type testDriverRows struct {
+	columns []string
+	values  [][]driver.Value
+	current int
+}
+
+func (tr *testDriverRows) Columns() []string {
+	return tr.columns
+}
+
+func (tr *testDriverRows) Close() error {
+	return nil
+}
+
+func (tr *testDriverRows) Next(dest []driver.Value) error {
+	if tr.current >= len(tr.values) {
+		return io.EOF
+	}
+	copy(dest, tr.values[tr.current])
+	tr.current++
+	return nil
+}
+
+func newTestDB(t *testing.T, td *testDatabase) *sql.DB {
+	db := sql.OpenDB(td)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+type testItem struct {
+	ID   int64  `jagsqlb:"id"`
+	Name string `jagsqlb:"name"`
+}
+
+func TestExec(t *testing.T) {
+	type wants struct {
+		query        string
+		params       []any
+		rowsAffected int64
+	}
+
+	tests := []struct {
+		name      string
+		td        *testDatabase
+		builder   builders.ExecutableBuilder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Success",
+			td:      &testDatabase{values: [][]driver.Value{{1}, {2}}},
+			builder: NewDeleteBuilder(nil, "items").Where(condition.Equals("id", 1)),
+			wants: wants{
+				query:        `DELETE FROM "items" WHERE "id" = $1;`,
+				params:       []any{int64(1)},
+				rowsAffected: 2,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Build",
+			td:        &testDatabase{},
+			builder:   NewDeleteBuilder(nil, ".items"),
+			assertion: assert.Error,
+		},
+		{
+			name:    "Error; Driver",
+			td:      &testDatabase{err: assert.AnError},
+			builder: NewDeleteBuilder(nil, "items"),
+			wants: wants{
+				query: `DELETE FROM "items";`,
+			},
+			assertion: func(tt assert.TestingT, err error, i ...any) bool {
+				var queryErr *intypes.QueryError
+				return assert.ErrorIs(tt, err, assert.AnError, i...) &&
+					assert.ErrorAs(tt, err, &queryErr, i...) &&
+					assert.Equal(tt, `DELETE FROM "items";`, queryErr.Query, i...)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.builder.Exec(context.Background(), newTestDB(t, tt.td))
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, tt.td.query)
+			assert.Equal(t, tt.wants.params, tt.td.params)
+			if err == nil {
+				rowsAffected, _ := result.RowsAffected()
+				assert.Equal(t, tt.wants.rowsAffected, rowsAffected)
+			}
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	td := &testDatabase{
+		columns: []string{"id", "name"},
+		values:  [][]driver.Value{{int64(1), "first"}, {int64(2), "second"}},
+	}
+	rows, err := NewSelectBuilder(nil, "items", "*").Limit(2).Query(context.Background(), newTestDB(t, td))
+	assert.NoError(t, err)
+	defer rows.Close()
+	assert.Equal(t, `SELECT * FROM "items" LIMIT 2;`, td.query)
+
+	var names []string
+	for rows.Next() {
+		var id int64
+		var name string
+		assert.NoError(t, rows.Scan(&id, &name))
+		names = append(names, name)
+	}
+	assert.Equal(t, []string{"first", "second"}, names)
+
+	_, err = NewSelectBuilder(nil, "items", "*").Query(context.Background(), newTestDB(t, &testDatabase{err: assert.AnError}))
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestQueryRow(t *testing.T) {
+	tests := []struct {
+		name      string
+		td        *testDatabase
+		builder   builders.ExecutableBuilder
+		want      string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			td: &testDatabase{
+				columns: []string{"name"},
+				values:  [][]driver.Value{{"first"}, {"second"}},
+			},
+			builder:   NewSelectBuilder(nil, "items", "name"),
+			want:      "first",
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Returning",
+			td: &testDatabase{
+				columns: []string{"name"},
+				values:  [][]driver.Value{{"inserted"}},
+			},
+			builder:   NewInsertBuilder(nil, "items").Data(testItem{Name: "inserted"}).Returning("name"),
+			want:      "inserted",
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Error; No Rows",
+			td:      &testDatabase{columns: []string{"name"}},
+			builder: NewSelectBuilder(nil, "items", "name"),
+			assertion: func(tt assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(tt, err, sql.ErrNoRows, i...)
+			},
+		},
+		{
+			name:      "Error; Build",
+			td:        &testDatabase{},
+			builder:   NewSelectBuilder(nil, ".items", "name"),
+			assertion: assert.Error,
+		},
+		{
+			name:    "Error; Driver",
+			td:      &testDatabase{err: assert.AnError},
+			builder: NewSelectBuilder(nil, "items", "name"),
+			assertion: func(tt assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(tt, err, assert.AnError, i...)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			err := tt.builder.QueryRow(context.Background(), newTestDB(t, tt.td)).Scan(&got)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	td := &testDatabase{
+		columns: []string{"id", "name"},
+		values:  [][]driver.Value{{int64(1), "first"}},
+	}
+	got, err := Get[testItem](context.Background(), newTestDB(t, td), NewSelectBuilder(nil, "items", "*").Where(condition.Equals("id", 1)))
+	assert.NoError(t, err)
+	assert.Equal(t, testItem{ID: 1, Name: "first"}, got)
+	assert.Equal(t, `SELECT * FROM "items" WHERE "id" = $1;`, td.query)
+
+	_, err = Get[testItem](context.Background(), newTestDB(t, &testDatabase{columns: []string{"id"}}), NewSelectBuilder(nil, "items", "*"))
+	var queryErr *intypes.QueryError
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	if assert.ErrorAs(t, err, &queryErr) {
+		assert.Equal(t, `SELECT * FROM "items";`, queryErr.Query)
+	}
+
+	_, err = Get[testItem](context.Background(), newTestDB(t, &testDatabase{}), NewSelectBuilder(nil, ".items", "*"))
+	assert.Error(t, err)
+}
+
+func TestSelect(t *testing.T) {
+	td := &testDatabase{
+		columns: []string{"id", "name"},
+		values:  [][]driver.Value{{int64(1), "first"}, {int64(2), "second"}},
+	}
+	got, err := Select[testItem](context.Background(), newTestDB(t, td), NewSelectBuilder(nil, "items", "*"))
+	assert.NoError(t, err)
+	assert.Equal(t, []testItem{{ID: 1, Name: "first"}, {ID: 2, Name: "second"}}, got)
+
+	_, err = Select[testItem](context.Background(), newTestDB(t, &testDatabase{columns: []string{"age"}, values: [][]driver.Value{{int64(42)}}}), NewSelectBuilder(nil, "items", "*"))
+	var queryErr *intypes.QueryError
+	if assert.ErrorAs(t, err, &queryErr) {
+		assert.Equal(t, `SELECT * FROM "items";`, queryErr.Query)
+	}
+
+	_, err = Select[testItem](context.Background(), newTestDB(t, &testDatabase{err: assert.AnError}), NewSelectBuilder(nil, "items", "*"))
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,325 @@
+package inbuilders
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
+	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+// groupByBuilder implements `builders.GroupByBuilder` and represents the GROUP BY clause in a SELECT statement
+type groupByBuilder struct {
+	precedingBuilder builders.Builder
+	columns          []intypes.Expression
+	dialect          dialect.Dialect
+	errs             intypes.ErrorSlice
+}
+
+func (gbb groupByBuilder) Build() (string, []any, error) {
+	return finalizeBuild(gbb.dialect, gbb)
+}
+
+func (gbb groupByBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, gbb)
+}
+
+func (gbb groupByBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, gbb)
+}
+
+func (gbb groupByBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, gbb)
+}
+
+func (gbb groupByBuilder) BuildRaw() (string, []any, error) {
+	if len(gbb.errs) > 0 {
+		return "", nil, gbb.errs
+	}
+
+	query, params, err := buildRaw(gbb.precedingBuilder)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build preceding query: %w", err)
+	}
+
+	d := dialectOrDefault(gbb.dialect)
+	sb := new(strings.Builder)
+	sb.WriteString(query[:len(query)-1])
+	sb.WriteString(" GROUP BY ")
+
+	var columnParams []any
+	for i, column := range gbb.columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		columnStr, exprParams, err := column.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to render GROUP BY column: %w", err)
+		}
+		sb.WriteString(columnStr)
+		columnParams = append(columnParams, exprParams...)
+	}
+	sb.WriteRune(';')
+
+	return sb.String(), append(params, columnParams...), nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (gbb groupByBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(gbb.dialect)
+}
+
+func (gbb groupByBuilder) Having(cond incondition.Condition, moreConds ...incondition.Condition) builders.HavingBuilder {
+	var hb builders.HavingBuilder = havingBuilder{
+		mainQuery:  gbb,
+		conditions: whereConditions{{condition: cond}},
+		dialect:    gbb.dialect,
+	}
+
+	if len(moreConds) > 0 {
+		hb = hb.And(moreConds[0], moreConds[1:]...)
+	}
+
+	return hb
+}
+
+func (gbb groupByBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(gbb.dialect, gbb)
+}
+
+func (gbb groupByBuilder) Window(name string, definition inexpr.Window) builders.WindowBuilder {
+	return newWindowBuilder(gbb.dialect, gbb).Window(name, definition)
+}
+
+func (gbb groupByBuilder) Union(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(gbb.dialect, gbb, compoundUnion, query)
+}
+
+func (gbb groupByBuilder) UnionAll(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(gbb.dialect, gbb, compoundUnionAll, query)
+}
+
+func (gbb groupByBuilder) Intersect(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(gbb.dialect, gbb, compoundIntersect, query)
+}
+
+func (gbb groupByBuilder) Except(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(gbb.dialect, gbb, compoundExcept, query)
+}
+
+func (gbb groupByBuilder) Limit(limit uint) builders.LockableBuilder {
+	return limitBuilder{
+		precedingBuilder: gbb,
+		limit:            limit,
+		dialect:          gbb.dialect,
+	}
+}
+
+func (gbb groupByBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(gbb.dialect, gbb).ForUpdate(tables...)
+}
+
+func (gbb groupByBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(gbb.dialect, gbb).ForNoKeyUpdate(tables...)
+}
+
+func (gbb groupByBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(gbb.dialect, gbb).ForShare(tables...)
+}
+
+func (gbb groupByBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(gbb.dialect, gbb).ForKeyShare(tables...)
+}
+
+func (gbb groupByBuilder) Offset(offset uint) builders.LimitBuilder {
+	return offsetBuilder{
+		precedingBuilder: gbb,
+		offset:           offset,
+		dialect:          gbb.dialect,
+	}
+}
+
+func (gbb groupByBuilder) OrderBy(ordering types.ColumnOrdering, moreOrderings ...types.ColumnOrdering) builders.OffsetBuilder {
+	return orderByBuilder{
+		precedingBuilder: gbb,
+		columnOrderings:  append([]types.ColumnOrdering{ordering}, moreOrderings...),
+		dialect:          gbb.dialect,
+	}
+}
+
+// havingBuilder implements `builders.HavingBuilder` and represents the HAVING clause in a SELECT statement
+type havingBuilder struct {
+	mainQuery  builders.Builder
+	conditions whereConditions
+	dialect    dialect.Dialect
+}
+
+func (hb havingBuilder) Build() (string, []any, error) {
+	return finalizeBuild(hb.dialect, hb)
+}
+
+func (hb havingBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, hb)
+}
+
+func (hb havingBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, hb)
+}
+
+func (hb havingBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, hb)
+}
+
+func (hb havingBuilder) BuildRaw() (string, []any, error) {
+	d := dialectOrDefault(hb.dialect)
+	mainQueryStr, params, err := buildRaw(hb.mainQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build preceding query: %w", err)
+	}
+
+	// If none of the conditions are applicable, then there is no "HAVING" clause
+	if hb.conditions.isEmpty() {
+		return mainQueryStr, params, nil
+	}
+
+	condStr, condParams, err := hb.conditions.parameterize(d)
+	if err != nil {
+		return "", nil, err
+	}
+
+	sb := new(strings.Builder)
+	sb.WriteString(mainQueryStr[:len(mainQueryStr)-1])
+	sb.WriteString(" HAVING ")
+	sb.WriteString(condStr)
+	sb.WriteRune(';')
+
+	return sb.String(), append(params, condParams...), nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (hb havingBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(hb.dialect)
+}
+
+func (hb havingBuilder) And(cond incondition.Condition, additionalConds ...incondition.Condition) builders.HavingBuilder {
+	hb.conditions.Append(whereCondition{
+		conjunction: "AND",
+		condition:   cond,
+	})
+
+	for _, cond := range additionalConds {
+		hb.conditions.Append(whereCondition{
+			conjunction: "AND",
+			condition:   cond,
+		})
+	}
+
+	return hb
+}
+
+func (hb havingBuilder) Or(cond incondition.Condition, additionalConds ...incondition.Condition) builders.HavingBuilder {
+	hb.conditions.Append(whereCondition{
+		conjunction: "OR",
+		condition:   cond,
+	})
+
+	for _, cond := range additionalConds {
+		hb.conditions.Append(whereCondition{
+			conjunction: "OR",
+			condition:   cond,
+		})
+	}
+
+	return hb
+}
+
+func (hb havingBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(hb.dialect, hb)
+}
+
+func (hb havingBuilder) Window(name string, definition inexpr.Window) builders.WindowBuilder {
+	return newWindowBuilder(hb.dialect, hb).Window(name, definition)
+}
+
+func (hb havingBuilder) Union(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(hb.dialect, hb, compoundUnion, query)
+}
+
+func (hb havingBuilder) UnionAll(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(hb.dialect, hb, compoundUnionAll, query)
+}
+
+func (hb havingBuilder) Intersect(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(hb.dialect, hb, compoundIntersect, query)
+}
+
+func (hb havingBuilder) Except(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(hb.dialect, hb, compoundExcept, query)
+}
+
+func (hb havingBuilder) Limit(limit uint) builders.LockableBuilder {
+	return limitBuilder{
+		precedingBuilder: hb,
+		limit:            limit,
+		dialect:          hb.dialect,
+	}
+}
+
+func (hb havingBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(hb.dialect, hb).ForUpdate(tables...)
+}
+
+func (hb havingBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(hb.dialect, hb).ForNoKeyUpdate(tables...)
+}
+
+func (hb havingBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(hb.dialect, hb).ForShare(tables...)
+}
+
+func (hb havingBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(hb.dialect, hb).ForKeyShare(tables...)
+}
+
+func (hb havingBuilder) Offset(offset uint) builders.LimitBuilder {
+	return offsetBuilder{
+		precedingBuilder: hb,
+		offset:           offset,
+		dialect:          hb.dialect,
+	}
+}
+
+func (hb havingBuilder) OrderBy(ordering types.ColumnOrdering, moreOrderings ...types.ColumnOrdering) builders.OffsetBuilder {
+	return orderByBuilder{
+		precedingBuilder: hb,
+		columnOrderings:  append([]types.ColumnOrdering{ordering}, moreOrderings...),
+		dialect:          hb.dialect,
+	}
+}
+
+// newGroupByBuilder creates a groupByBuilder that follows `precedingBuilder`. Each column must either be a string,
+// which will be parsed as a column reference, or an expression.
+func newGroupByBuilder(d dialect.Dialect, precedingBuilder builders.Builder, column any, moreColumns ...any) groupByBuilder {
+	gbb := groupByBuilder{
+		precedingBuilder: precedingBuilder,
+		dialect:          d,
+	}
+
+	for _, col := range append([]any{column}, moreColumns...) {
+		expr, err := parseColumnExpression("GROUP BY", col)
+		if err != nil {
+			gbb.errs = append(gbb.errs, err)
+			continue
+		}
+		gbb.columns = append(gbb.columns, expr)
+	}
+
+	return gbb
+}
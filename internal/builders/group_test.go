@@ -0,0 +1,368 @@
+package inbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/expr"
+	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/join"
+)
+
+func Test_groupByBuilder_Build(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		gbb       groupByBuilder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Single Column",
+			gbb:  newGroupByBuilder(nil, NewSelectBuilder(nil, "table1", "col1"), "col1"),
+			wants: wants{
+				query: `SELECT "col1" FROM "table1" GROUP BY "col1";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Multiple Columns After Where",
+			gbb: newGroupByBuilder(
+				nil,
+				NewSelectBuilder(nil, "table1 AS t1", "col1", "col2").Where(condition.Equals("col3", "test")),
+				"t1.col1", "t1.col2",
+			),
+			wants: wants{
+				query:  `SELECT "col1", "col2" FROM "table1" AS "t1" WHERE "col3" = $1 GROUP BY "t1"."col1", "t1"."col2";`,
+				params: []any{"test"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL",
+			gbb:  newGroupByBuilder(dialect.MySQL, NewSelectBuilder(dialect.MySQL, "table1", "col1"), "col1"),
+			wants: wants{
+				query: "SELECT `col1` FROM `table1` GROUP BY `col1`;",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Expressions",
+			gbb: newGroupByBuilder(
+				nil,
+				NewSelectBuilder(nil, "users", expr.Lower("email"), expr.Count("*")).Where(condition.Equals("active", true)),
+				expr.Lower("email"),
+				expr.Coalesce("region", expr.Value("none")),
+			),
+			wants: wants{
+				query:  `SELECT LOWER("email"), COUNT(*) FROM "users" WHERE "active" = $1 GROUP BY LOWER("email"), COALESCE("region", $2);`,
+				params: []any{true, "none"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Invalid Column Type",
+			gbb:       newGroupByBuilder(nil, NewSelectBuilder(nil, "table1", "col1"), 42),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Column",
+			gbb:       newGroupByBuilder(nil, NewSelectBuilder(nil, "table1", "col1"), ".col1"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Preceding Builder",
+			gbb:       newGroupByBuilder(nil, NewSelectBuilder(nil, ".table1", "col1"), "col1"),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.gbb.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func Test_groupByBuilder_Having(t *testing.T) {
+	type args struct {
+		cond      incondition.Condition
+		moreConds []incondition.Condition
+	}
+
+	testGroupByBuilder := groupByBuilder{
+		precedingBuilder: NewSelectBuilder(nil, "table1", "col1"),
+		columns:          []intypes.Expression{inexpr.Column{Name: "col1"}},
+	}
+	cond1 := condition.GreaterThan("col1", 42)
+	cond2 := condition.LessThan("col1", 56)
+
+	tests := []struct {
+		name string
+		gbb  groupByBuilder
+		args args
+		want builders.HavingBuilder
+	}{
+		{
+			name: "Success; Minimal",
+			gbb:  testGroupByBuilder,
+			args: args{
+				cond: cond1,
+			},
+			want: havingBuilder{
+				mainQuery:  testGroupByBuilder,
+				conditions: whereConditions{{condition: cond1}},
+			},
+		},
+		{
+			name: "Success; Multiple Conditions",
+			gbb:  testGroupByBuilder,
+			args: args{
+				cond:      cond1,
+				moreConds: []incondition.Condition{cond2},
+			},
+			want: havingBuilder{
+				mainQuery: testGroupByBuilder,
+				conditions: whereConditions{
+					{condition: cond1},
+					{condition: cond2, conjunction: "AND"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.gbb.Having(tt.args.cond, tt.args.moreConds...))
+		})
+	}
+}
+
+func Test_havingBuilder_Build(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		hb        builders.HavingBuilder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Single Condition",
+			hb:   NewSelectBuilder(nil, "table1", "col1").GroupBy("col1").Having(condition.GreaterThan("col1", 42)),
+			wants: wants{
+				query:  `SELECT "col1" FROM "table1" GROUP BY "col1" HAVING "col1" > $1;`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; After Join and Where",
+			hb: NewSelectBuilder(nil, "table1 AS t1", "col1").Join(
+				join.TypeInner,
+				"table2 AS t2",
+				join.On(condition.Equals("t1.id", condition.ColumnValue("t2.t1_id")), condition.NotEquals("t2.col2", "test")),
+			).Where(
+				condition.LessThan("t1.col3", 128),
+			).GroupBy("t1.col1").Having(
+				condition.GreaterThan("t1.col1", 42),
+			).Or(condition.IsNull("t1.col1")),
+			wants: wants{
+				query: `SELECT "t1"."col1" FROM "table1" AS "t1" INNER JOIN "table2" AS "t2" ON "t1"."id" = "t2"."t1_id" AND "t2"."col2" != $1` +
+					` WHERE "t1"."col3" < $2 GROUP BY "t1"."col1" HAVING "t1"."col1" > $3 OR "t1"."col1" IS NULL;`,
+				params: []any{"test", 128, 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQL Server",
+			hb: NewSelectBuilder(dialect.SQLServer, "table1", "col1").Where(
+				condition.Equals("col2", "test"),
+			).GroupBy("col1").Having(condition.GreaterThan("col1", 42)),
+			wants: wants{
+				query:  `SELECT [col1] FROM [table1] WHERE [col2] = @p1 GROUP BY [col1] HAVING [col1] > @p2;`,
+				params: []any{"test", 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Expressions",
+			hb: NewSelectBuilder(
+				nil, "orders AS o", "o.customer_id", expr.Sum("o.price").As("total"),
+			).Join(
+				join.TypeInner,
+				"customers AS c",
+				join.On(condition.Equals("c.id", condition.ColumnValue("o.customer_id")), condition.Equals("c.region", "west")),
+			).GroupBy("o.customer_id").Having(
+				condition.GreaterThan(expr.Sum("o.price"), 100),
+			),
+			wants: wants{
+				query: `SELECT "o"."customer_id", SUM("o"."price") AS "total" FROM "orders" AS "o" INNER JOIN "customers" AS "c"` +
+					` ON "c"."id" = "o"."customer_id" AND "c"."region" = $1 GROUP BY "o"."customer_id" HAVING SUM("o"."price") > $2;`,
+				params: []any{"west", 100},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Bad Condition",
+			hb:        NewSelectBuilder(nil, "table1", "col1").GroupBy("col1").Having(condition.GreaterThan(".col1", 42)),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.hb.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func Test_havingBuilder_And(t *testing.T) {
+	type args struct {
+		cond            incondition.Condition
+		additionalConds []incondition.Condition
+	}
+
+	testHavingCond1 := whereCondition{
+		condition: condition.GreaterThan("col1", 42),
+	}
+	testCondInput1 := condition.LessThan("col1", 56)
+	testCondInput2 := condition.NotEquals("col1", 50)
+
+	tests := []struct {
+		name string
+		hb   havingBuilder
+		args args
+		want builders.HavingBuilder
+	}{
+		{
+			name: "Single Condition",
+			hb: havingBuilder{
+				conditions: whereConditions{testHavingCond1},
+			},
+			args: args{
+				cond: testCondInput1,
+			},
+			want: havingBuilder{
+				conditions: whereConditions{
+					testHavingCond1,
+					{conjunction: "AND", condition: testCondInput1},
+				},
+			},
+		},
+		{
+			name: "Multiple Conditions",
+			hb: havingBuilder{
+				conditions: whereConditions{testHavingCond1},
+			},
+			args: args{
+				cond:            testCondInput1,
+				additionalConds: []incondition.Condition{testCondInput2},
+			},
+			want: havingBuilder{
+				conditions: whereConditions{
+					testHavingCond1,
+					{conjunction: "AND", condition: testCondInput1},
+					{conjunction: "AND", condition: testCondInput2},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.hb.And(tt.args.cond, tt.args.additionalConds...))
+		})
+	}
+}
+
+func Test_havingBuilder_Or(t *testing.T) {
+	type args struct {
+		cond            incondition.Condition
+		additionalConds []incondition.Condition
+	}
+
+	testHavingCond1 := whereCondition{
+		condition: condition.GreaterThan("col1", 42),
+	}
+	testCondInput1 := condition.LessThan("col1", 56)
+	testCondInput2 := condition.NotEquals("col1", 50)
+
+	tests := []struct {
+		name string
+		hb   havingBuilder
+		args args
+		want builders.HavingBuilder
+	}{
+		{
+			name: "Single Condition",
+			hb: havingBuilder{
+				conditions: whereConditions{testHavingCond1},
+			},
+			args: args{
+				cond: testCondInput1,
+			},
+			want: havingBuilder{
+				conditions: whereConditions{
+					testHavingCond1,
+					{conjunction: "OR", condition: testCondInput1},
+				},
+			},
+		},
+		{
+			name: "Multiple Conditions",
+			hb: havingBuilder{
+				conditions: whereConditions{testHavingCond1},
+			},
+			args: args{
+				cond:            testCondInput1,
+				additionalConds: []incondition.Condition{testCondInput2},
+			},
+			want: havingBuilder{
+				conditions: whereConditions{
+					testHavingCond1,
+					{conjunction: "OR", condition: testCondInput1},
+					{conjunction: "OR", condition: testCondInput2},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.hb.Or(tt.args.cond, tt.args.additionalConds...))
+		})
+	}
+}
+
+func Test_havingBuilder_And_Branches(t *testing.T) {
+	// Three conditions leave room in the slice of conditions for a fourth without it needing to grow
+	base := NewSelectBuilder(nil, "t", "a").GroupBy("a").
+		Having(condition.Equals("a", 1)).And(condition.Equals("b", 2)).And(condition.Equals("c", 9))
+
+	first := base.And(condition.Equals("x", 3))
+	second := base.Or(condition.Equals("y", 4))
+
+	gotQuery, gotParams, err := first.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT "a" FROM "t" GROUP BY "a" HAVING "a" = $1 AND "b" = $2 AND "c" = $3 AND "x" = $4;`, gotQuery)
+	assert.Equal(t, []any{1, 2, 9, 3}, gotParams)
+
+	gotQuery, gotParams, err = second.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT "a" FROM "t" GROUP BY "a" HAVING "a" = $1 AND "b" = $2 AND "c" = $3 OR "y" = $4;`, gotQuery)
+	assert.Equal(t, []any{1, 2, 9, 4}, gotParams)
+}
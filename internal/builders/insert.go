@@ -1,10 +1,14 @@
 package inbuilders
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 	"github.com/williabk198/jagsqlb/internal/utilities/parsers"
 )
@@ -13,41 +17,90 @@ type insertBuilder struct {
 	table   intypes.Table
 	columns []intypes.Column
 	values  [][]any
+	query   builders.Builder
+	with    withClause
+	dialect dialect.Dialect
 
 	errs intypes.ErrorSlice
 }
 
 func (ib insertBuilder) Build() (query string, params []any, err error) {
+	return finalizeBuild(ib.dialect, ib)
+}
+
+func (ib insertBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, ib)
+}
+
+func (ib insertBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, ib)
+}
+
+func (ib insertBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, ib)
+}
+
+func (ib insertBuilder) BuildRaw() (query string, params []any, err error) {
+	query, params, err = ib.buildStatement()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return ib.with.prefix(dialectOrDefault(ib.dialect), query, params)
+}
+
+// Dialect implements intypes.RawBuilder.
+func (ib insertBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(ib.dialect)
+}
+
+// buildStatement builds the INSERT statement without any of its preceding common table expressions
+func (ib insertBuilder) buildStatement() (query string, params []any, err error) {
 	if len(ib.errs) > 0 {
 		return "", nil, fmt.Errorf("error(s) exist preceding the build process of the insert statement: %w", ib.errs)
 	}
 
-	if len(ib.columns) == 0 && len(ib.values) == 0 {
-		return fmt.Sprintf("INSERT INTO %s DEFAULT VALUES;", ib.table), nil, nil
+	d := dialectOrDefault(ib.dialect)
+	if len(ib.columns) == 0 && len(ib.values) == 0 && ib.query == nil {
+		if !d.Supports(dialect.ClauseDefaultValues) {
+			// Dialects that don't support "DEFAULT VALUES" (e.g. MySQL) will use the defaults when given empty lists instead
+			return fmt.Sprintf("INSERT INTO %s () VALUES ();", ib.table.Render(d)), nil, nil
+		}
+		return fmt.Sprintf("INSERT INTO %s DEFAULT VALUES;", ib.table.Render(d)), nil, nil
 	}
 
 	sb := new(strings.Builder)
 	sb.WriteString("INSERT INTO ")
-	sb.WriteString(ib.table.String())
+	sb.WriteString(ib.table.Render(d))
 
 	if len(ib.columns) > 0 {
 		sb.WriteString(" (")
-		sb.WriteString(ib.columns[0].String())
+		sb.WriteString(ib.columns[0].Render(d))
 		for i := 1; i < len(ib.columns); i++ {
 			sb.WriteString(", ")
-			sb.WriteString(ib.columns[i].String())
+			sb.WriteString(ib.columns[i].Render(d))
 		}
 		sb.WriteString(")")
 	}
 
+	if ib.query != nil {
+		selectQuery, selectParams, err := intypes.BuildNested(d, ib.query)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to build the query of the insert statement: %w", err)
+		}
+
+		sb.WriteRune(' ')
+		sb.WriteString(selectQuery[:len(selectQuery)-1])
+		sb.WriteRune(';')
+		return sb.String(), selectParams, nil
+	}
+
 	sb.WriteString(" VALUES")
 
-	for i, val := range ib.values {
-		sb.WriteString(" (")
-		fmt.Fprintf(sb, "$%d", i*len(val)+1)
+	for _, val := range ib.values {
+		sb.WriteString(" (?")
 		for j := 1; j < len(val); j++ {
-			sb.WriteString(", ")
-			fmt.Fprintf(sb, "$%d", i*len(val)+j+1)
+			sb.WriteString(", ?")
 		}
 		sb.WriteRune(')')
 	}
@@ -60,31 +113,44 @@ func (ib insertBuilder) Build() (query string, params []any, err error) {
 	return sb.String(), params, nil
 }
 
-func (ib insertBuilder) Values(vals []any, moreVals ...[]any) builders.ReturningBuilder {
+func (ib insertBuilder) Values(vals []any, moreVals ...[]any) builders.InsertConflictBuilder {
+	// Copy the slices so that appending to them doesn't affect any other builder sharing the same backing arrays
+	ib.values = slices.Clone(ib.values)
+	ib.errs = slices.Clone(ib.errs)
+
 	if len(ib.columns) > 0 && len(ib.columns) != len(vals) {
 		ib.errs = append(ib.errs, fmt.Errorf("%d column(s) provided but %d value(s) were given(%v)", len(ib.columns), len(vals), vals))
-		return returningBuilder{
-			prevBuilder: ib,
-		}
+		return ib
 	}
 	ib.values = append(ib.values, vals)
 
 	for _, mv := range moreVals {
 		if len(ib.columns) > 0 && len(ib.columns) != len(mv) {
 			ib.errs = append(ib.errs, fmt.Errorf("%d column(s) provided but %d value(s) were given(%v)", len(ib.columns), len(mv), mv))
-			return returningBuilder{
-				prevBuilder: ib,
-			}
+			return ib
 		}
 		ib.values = append(ib.values, mv)
 	}
 
-	return returningBuilder{
-		prevBuilder: ib,
+	return ib
+}
+
+func (ib insertBuilder) FromSelect(query builders.Builder) builders.InsertConflictBuilder {
+	if query == nil {
+		ib.errs = append(slices.Clone(ib.errs), fmt.Errorf("no query was provided to insert from"))
+		return ib
 	}
+	ib.query = query
+	ib.values = nil
+
+	return ib
 }
 
 func (ib insertBuilder) Columns(column string, moreColumns ...string) builders.InsertValueBuilder {
+	// Copy the slices so that appending to them doesn't affect any other builder sharing the same backing arrays
+	ib.columns = slices.Clone(ib.columns)
+	ib.errs = slices.Clone(ib.errs)
+
 	columnData, err := columnParser.Parse(column)
 	if err != nil {
 		ib.errs = append(ib.errs, err)
@@ -103,33 +169,28 @@ func (ib insertBuilder) Columns(column string, moreColumns ...string) builders.I
 	return ib
 }
 
-func (ib insertBuilder) DefaultValues() builders.ReturningBuilder {
-	// Ensure that both columns and values are empty
+func (ib insertBuilder) DefaultValues() builders.InsertConflictBuilder {
+	// Ensure that the columns, values and query are all empty
 	ib.columns = nil
 	ib.values = nil
+	ib.query = nil
 
-	return returningBuilder{
-		prevBuilder: ib,
-	}
+	return ib
 }
 
-func (ib insertBuilder) Data(data any, moreData ...any) builders.ReturningBuilder {
+func (ib insertBuilder) Data(data any, moreData ...any) builders.InsertConflictBuilder {
 	cols, vals, err := parsers.ParseColumnTag(intypes.QueryTypeInsert, data)
 	if err != nil {
-		ib.errs = append(ib.errs, fmt.Errorf("failed to process argument 0 of Data function: %w", err))
-		return returningBuilder{
-			prevBuilder: ib,
-		}
+		ib.errs = append(slices.Clone(ib.errs), fmt.Errorf("failed to process argument 0 of Data function: %w", err))
+		return ib
 	}
 
 	moreVals := make([][]any, len(moreData))
 	for i, md := range moreData {
 		_, valData, err := parsers.ParseColumnTag(intypes.QueryTypeInsert, md)
 		if err != nil {
-			ib.errs = append(ib.errs, fmt.Errorf("failed to process argument %d of Data function: %w", i+1, err))
-			return returningBuilder{
-				prevBuilder: ib,
-			}
+			ib.errs = append(slices.Clone(ib.errs), fmt.Errorf("failed to process argument %d of Data function: %w", i+1, err))
+			return ib
 		}
 		moreVals[i] = valData
 	}
@@ -138,8 +199,42 @@ func (ib insertBuilder) Data(data any, moreData ...any) builders.ReturningBuilde
 	return valBuilder.Values(vals, moreVals...)
 }
 
-func NewInsertBuilder(table string) builders.InsertBuilder {
-	ib := insertBuilder{}
+func (ib insertBuilder) OnConflict(columns ...string) builders.ConflictActionBuilder {
+	cb := newConflictBuilder(ib)
+	for _, column := range columns {
+		columnData, err := columnParser.Parse(column)
+		if err != nil {
+			cb.errs = append(cb.errs, fmt.Errorf("failed to parse conflict target column %q: %w", column, err))
+			continue
+		}
+		cb.targetColumns = append(cb.targetColumns, columnData)
+	}
+
+	return cb
+}
+
+func (ib insertBuilder) OnConstraint(name string) builders.ConflictActionBuilder {
+	cb := newConflictBuilder(ib)
+	if name == "" {
+		cb.errs = append(cb.errs, fmt.Errorf("no constraint name was provided for the conflict target"))
+	}
+	cb.constraint = name
+
+	return cb
+}
+
+func (ib insertBuilder) Returning(column string, moreColumns ...string) builders.ExecutableBuilder {
+	rb := returningBuilder{
+		prevBuilder: ib,
+		dialect:     ib.dialect,
+	}
+	return rb.Returning(column, moreColumns...)
+}
+
+// NewInsertBuilder creates an InsertBuilder that renders its query using the provided dialect.
+// If `d` is nil, then PostgreSQL will be used.
+func NewInsertBuilder(d dialect.Dialect, table string) builders.InsertBuilder {
+	ib := insertBuilder{dialect: d}
 	tableData, err := tableParser.Parse(table)
 	if err != nil {
 		ib.errs = append(ib.errs, err)
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
@@ -93,6 +95,75 @@ func Test_insertBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; MySQL",
+			ib: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				columns: []intypes.Column{{Name: "col1"}, {Name: "col2"}},
+				values: [][]any{
+					{"test", 13},
+				},
+				dialect: dialect.MySQL,
+			},
+			wants: wants{
+				query:  "INSERT INTO `table1` (`col1`, `col2`) VALUES (?, ?);",
+				params: []any{"test", 13},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQL Server",
+			ib: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				columns: []intypes.Column{{Name: "col1"}, {Name: "col2"}},
+				values: [][]any{
+					{"test", 13},
+				},
+				dialect: dialect.SQLServer,
+			},
+			wants: wants{
+				query:  `INSERT INTO [table1] ([col1], [col2]) VALUES (@p1, @p2);`,
+				params: []any{"test", 13},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Default Values w/ MySQL",
+			ib: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				dialect: dialect.MySQL,
+			},
+			wants: wants{
+				query: "INSERT INTO `table1` () VALUES ();",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; From Select",
+			ib: insertBuilder{
+				table:   intypes.Table{Name: "archive"},
+				columns: []intypes.Column{{Name: "id"}, {Name: "name"}},
+				query:   NewSelectBuilder(nil, "orders", "id", "name").Where(condition.LessThan("placed_at", 100)),
+			},
+			wants: wants{
+				query:  `INSERT INTO "archive" ("id", "name") SELECT "id", "name" FROM "orders" WHERE "placed_at" < $1;`,
+				params: []any{100},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; From Select w/o Columns w/ SQL Server",
+			ib: insertBuilder{
+				table:   intypes.Table{Name: "archive"},
+				query:   NewSelectBuilder(dialect.SQLServer, "orders", "*").Where(condition.Equals("status", "closed")),
+				dialect: dialect.SQLServer,
+			},
+			wants: wants{
+				query:  `INSERT INTO [archive] SELECT * FROM [orders] WHERE [status] = @p1;`,
+				params: []any{"closed"},
+			},
+			assertion: assert.NoError,
+		},
 		{
 			name: "Error; ErrorSlice not Empty",
 			ib: insertBuilder{
@@ -100,6 +171,14 @@ func Test_insertBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.Error,
 		},
+		{
+			name: "Error; From Select",
+			ib: insertBuilder{
+				table: intypes.Table{Name: "archive"},
+				query: NewSelectBuilder(nil, ".orders", "*"),
+			},
+			assertion: assert.Error,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -126,7 +205,7 @@ func Test_insertBuilder_Values(t *testing.T) {
 		name string
 		ib   insertBuilder
 		args args
-		want builders.ReturningBuilder
+		want builders.InsertConflictBuilder
 	}{
 		{
 			name: "Success; Single Value Slice",
@@ -134,13 +213,11 @@ func Test_insertBuilder_Values(t *testing.T) {
 			args: args{
 				vals: []any{"something", 17, 1.23},
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					table:   intypes.Table{Name: "table1"},
-					columns: []intypes.Column{{Name: "col1"}, {Name: "col2"}, {Name: "ts"}},
-					values: [][]any{
-						{"something", 17, 1.23},
-					},
+			want: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				columns: []intypes.Column{{Name: "col1"}, {Name: "col2"}, {Name: "ts"}},
+				values: [][]any{
+					{"something", 17, 1.23},
 				},
 			},
 		},
@@ -153,14 +230,12 @@ func Test_insertBuilder_Values(t *testing.T) {
 					{"something_else", 7, 4.56},
 				},
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					table:   intypes.Table{Name: "table1"},
-					columns: []intypes.Column{{Name: "col1"}, {Name: "col2"}, {Name: "ts"}},
-					values: [][]any{
-						{"something", 17, 1.23},
-						{"something_else", 7, 4.56},
-					},
+			want: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				columns: []intypes.Column{{Name: "col1"}, {Name: "col2"}, {Name: "ts"}},
+				values: [][]any{
+					{"something", 17, 1.23},
+					{"something_else", 7, 4.56},
 				},
 			},
 		},
@@ -174,14 +249,12 @@ func Test_insertBuilder_Values(t *testing.T) {
 			args: args{
 				vals: []any{"testing", "too_many_vals"},
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					table:   intypes.Table{Name: "table1"},
-					columns: []intypes.Column{{Name: "col1"}},
-					values:  [][]any{},
-					errs: intypes.ErrorSlice{
-						fmt.Errorf("1 column(s) provided but 2 value(s) were given(%v)", []any{"testing", "too_many_vals"}),
-					},
+			want: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				columns: []intypes.Column{{Name: "col1"}},
+				values:  [][]any{},
+				errs: intypes.ErrorSlice{
+					fmt.Errorf("1 column(s) provided but 2 value(s) were given(%v)", []any{"testing", "too_many_vals"}),
 				},
 			},
 		},
@@ -198,16 +271,14 @@ func Test_insertBuilder_Values(t *testing.T) {
 					{"testing"},
 				},
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					table:   intypes.Table{Name: "table1"},
-					columns: []intypes.Column{{Name: "col1"}, {Name: "col2"}},
-					values: [][]any{
-						{"testing", 56},
-					},
-					errs: intypes.ErrorSlice{
-						fmt.Errorf("2 column(s) provided but 1 value(s) were given(%v)", []any{"testing"}),
-					},
+			want: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				columns: []intypes.Column{{Name: "col1"}, {Name: "col2"}},
+				values: [][]any{
+					{"testing", 56},
+				},
+				errs: intypes.ErrorSlice{
+					fmt.Errorf("2 column(s) provided but 1 value(s) were given(%v)", []any{"testing"}),
 				},
 			},
 		},
@@ -219,6 +290,48 @@ func Test_insertBuilder_Values(t *testing.T) {
 	}
 }
 
+func Test_insertBuilder_FromSelect(t *testing.T) {
+	testQuery := NewSelectBuilder(nil, "orders", "id", "name")
+
+	tests := []struct {
+		name  string
+		ib    insertBuilder
+		query builders.Builder
+		want  builders.InsertConflictBuilder
+	}{
+		{
+			name: "Success",
+			ib: insertBuilder{
+				table:   intypes.Table{Name: "archive"},
+				columns: []intypes.Column{{Name: "id"}, {Name: "name"}},
+			},
+			query: testQuery,
+			want: insertBuilder{
+				table:   intypes.Table{Name: "archive"},
+				columns: []intypes.Column{{Name: "id"}, {Name: "name"}},
+				query:   testQuery,
+			},
+		},
+		{
+			name: "Error; Missing Query",
+			ib: insertBuilder{
+				table: intypes.Table{Name: "archive"},
+			},
+			want: insertBuilder{
+				table: intypes.Table{Name: "archive"},
+				errs: intypes.ErrorSlice{
+					fmt.Errorf("no query was provided to insert from"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ib.FromSelect(tt.query))
+		})
+	}
+}
+
 func Test_insertBuilder_Columns(t *testing.T) {
 	type args struct {
 		column      string
@@ -293,17 +406,15 @@ func Test_insertBuilder_DefaultValues(t *testing.T) {
 	tests := []struct {
 		name string
 		ib   insertBuilder
-		want builders.ReturningBuilder
+		want builders.InsertConflictBuilder
 	}{
 		{
 			name: "Success",
 			ib: insertBuilder{
 				table: intypes.Table{Name: "table1"},
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					table: intypes.Table{Name: "table1"},
-				},
+			want: insertBuilder{
+				table: intypes.Table{Name: "table1"},
 			},
 		},
 	}
@@ -329,7 +440,7 @@ func Test_insertBuilder_Data(t *testing.T) {
 		name string
 		ib   insertBuilder
 		args args
-		want builders.ReturningBuilder
+		want builders.InsertConflictBuilder
 	}{
 		{
 			name: "Success; Struct with no Tags",
@@ -339,13 +450,11 @@ func Test_insertBuilder_Data(t *testing.T) {
 			args: args{
 				data: struct{ Data string }{"testing"},
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					table:   intypes.Table{Name: "table1"},
-					columns: []intypes.Column{{Name: "Data"}},
-					values: [][]any{
-						{"testing"},
-					},
+			want: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				columns: []intypes.Column{{Name: "Data"}},
+				values: [][]any{
+					{"testing"},
 				},
 			},
 		},
@@ -359,13 +468,11 @@ func Test_insertBuilder_Data(t *testing.T) {
 					Data int `jagsqlb:"data"`
 				}{56},
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					table:   intypes.Table{Name: "table1"},
-					columns: []intypes.Column{{Name: "data"}},
-					values: [][]any{
-						{56},
-					},
+			want: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				columns: []intypes.Column{{Name: "data"}},
+				values: [][]any{
+					{56},
 				},
 			},
 		},
@@ -380,14 +487,12 @@ func Test_insertBuilder_Data(t *testing.T) {
 					testData{"test2", 93},
 				},
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					table:   intypes.Table{Name: "table1"},
-					columns: []intypes.Column{{Name: "string_data"}, {Name: "IntData"}},
-					values: [][]any{
-						{"test1", 42},
-						{"test2", 93},
-					},
+			want: insertBuilder{
+				table:   intypes.Table{Name: "table1"},
+				columns: []intypes.Column{{Name: "string_data"}, {Name: "IntData"}},
+				values: [][]any{
+					{"test1", 42},
+					{"test2", 93},
 				},
 			},
 		},
@@ -399,15 +504,13 @@ func Test_insertBuilder_Data(t *testing.T) {
 			args: args{
 				data: 77,
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					table: intypes.Table{Name: "table1"},
-					errs: intypes.ErrorSlice{
-						fmt.Errorf(
-							"failed to process argument 0 of Data function: %w",
-							fmt.Errorf("received value is not a struct type"),
-						),
-					},
+			want: insertBuilder{
+				table: intypes.Table{Name: "table1"},
+				errs: intypes.ErrorSlice{
+					fmt.Errorf(
+						"failed to process argument 0 of Data function: %w",
+						fmt.Errorf("received value is not a struct type"),
+					),
 				},
 			},
 		},
@@ -418,14 +521,12 @@ func Test_insertBuilder_Data(t *testing.T) {
 				data:     struct{ Data string }{"hi"},
 				moreData: []any{"bad_val"},
 			},
-			want: returningBuilder{
-				prevBuilder: insertBuilder{
-					errs: intypes.ErrorSlice{
-						fmt.Errorf(
-							"failed to process argument 1 of Data function: %w",
-							fmt.Errorf("received value is not a struct type"),
-						),
-					},
+			want: insertBuilder{
+				errs: intypes.ErrorSlice{
+					fmt.Errorf(
+						"failed to process argument 1 of Data function: %w",
+						fmt.Errorf("received value is not a struct type"),
+					),
 				},
 			},
 		},
@@ -469,7 +570,7 @@ func TestNewInsertBuilder(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, NewInsertBuilder(tt.args.table))
+			assert.Equal(t, tt.want, NewInsertBuilder(nil, tt.args.table))
 		})
 	}
 }
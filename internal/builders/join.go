@@ -1,11 +1,16 @@
 package inbuilders
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
 	injoin "github.com/williabk198/jagsqlb/internal/join"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 	inutilities "github.com/williabk198/jagsqlb/internal/utilities"
@@ -18,66 +23,101 @@ type joinCondition struct {
 	joinRelation injoin.Relation
 }
 
-type joinBuilder struct {
-	selectBuilder selectBuilder
-	joins         []joinCondition
-	errs          intypes.ErrorSlice
-}
-
-func (jb joinBuilder) Build() (query string, queryParams []any, err error) {
-	if len(jb.errs) > 0 {
-		return "", nil, jb.errs
+// newJoinCondition parses the table being joined and pairs it with how it is joined
+func newJoinCondition(joinType injoin.Type, table any, joinRelation injoin.Relation) (joinCondition, error) {
+	tableData, err := parseTableSource(table)
+	if err != nil {
+		return joinCondition{}, fmt.Errorf("failed to parse table in JOIN clause: %w", err)
 	}
 
+	return joinCondition{
+		joinTable:    tableData,
+		joinType:     joinType,
+		joinRelation: joinRelation,
+	}, nil
+}
+
+// renderJoins renders each of the provided join clauses, with each one preceded by a space, along with their parameters
+func renderJoins(d dialect.Dialect, joins []joinCondition) (string, []any, error) {
 	sb := new(strings.Builder)
+	var params []any
 
-	// Need to build the select query manually here since `selectBuilder.Build` doesn't produce
-	// the desired string. Mainly, it won't prepend table data if only one table was defined
-	// in `selectBuilder`
-	columnStr := inutilities.CoalesceSelectColumnsFullString(jb.selectBuilder.columns)
-	tableStr := inutilities.CoalesceTablesString(jb.selectBuilder.tables)
+	for _, joinCond := range joins {
+		switch {
+		case joinCond.joinType.IsFull() && !d.Supports(dialect.ClauseFullJoin):
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseFullJoin)
+		case joinCond.joinType.IsNatural() && !d.Supports(dialect.ClauseNaturalJoin):
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseNaturalJoin)
+		}
 
-	sb.WriteString("SELECT ")
-	sb.WriteString(columnStr)
-	sb.WriteString("FROM ")
-	sb.WriteString(tableStr)
+		tableStr, tableParams, err := joinCond.joinTable.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to render table in %s: %w", joinCond.joinType, err)
+		}
+		params = append(params, tableParams...)
 
-	for _, joinCond := range jb.joins {
 		sb.WriteRune(' ')
 		sb.WriteString(string(joinCond.joinType))
 		sb.WriteRune(' ')
-		sb.WriteString(joinCond.joinTable.String())
+		sb.WriteString(tableStr)
+
+		if !joinCond.joinType.HasRelation() {
+			if joinCond.joinRelation.Keyword != "" {
+				return "", nil, fmt.Errorf("%s cannot have a relation, but received one with the %q keyword", joinCond.joinType, joinCond.joinRelation.Keyword)
+			}
+			continue
+		}
+
+		if joinCond.joinRelation.Keyword == "" {
+			// A LATERAL subquery usually holds the conditions that join it to the preceding tables
+			if joinCond.joinTable.Lateral {
+				sb.WriteString(" ON TRUE")
+				continue
+			}
+			return "", nil, fmt.Errorf("%s needs an ON or USING relation", joinCond.joinType)
+		}
+
 		sb.WriteRune(' ')
 		sb.WriteString(joinCond.joinRelation.Keyword)
 		sb.WriteRune(' ')
 
-		if columnStr, ok := joinCond.joinRelation.Relation.(string); ok && joinCond.joinRelation.Keyword == "USING" {
-			column, err := columnParser.Parse(columnStr)
-			if err != nil {
-				return "", nil, fmt.Errorf("USING column %q was malformed: %w", columnStr, err)
+		if columnNames, ok := usingColumnNames(joinCond.joinRelation); ok {
+			columnStrs := make([]string, len(columnNames))
+			for i, columnName := range columnNames {
+				column, err := columnParser.Parse(columnName)
+				if err != nil {
+					return "", nil, fmt.Errorf("USING column %q was malformed: %w", columnName, err)
+				}
+				columnStrs[i] = column.Render(d)
 			}
 			sb.WriteRune('(')
-			sb.WriteString(column.String())
+			sb.WriteString(strings.Join(columnStrs, ", "))
 			sb.WriteRune(')')
 			continue
 		}
 
 		if conditions, ok := joinCond.joinRelation.Relation.([]incondition.Condition); ok && joinCond.joinRelation.Keyword == "ON" {
-			condStr, condParams, err := conditions[0].Parameterize()
+			// Skip any conditions that are not applicable. However, at least one is needed to join the tables on.
+			conditions = incondition.RemoveEmpty(conditions)
+			if len(conditions) == 0 {
+				return "", nil, fmt.Errorf("no applicable ON conditions were provided for %q", joinCond.joinType)
+			}
+
+			condStr, condParams, err := conditions[0].Parameterize(d)
 			if err != nil {
 				return "", nil, fmt.Errorf("failed to parameterize ON condition for %q: %w", joinCond.joinType, err)
 			}
-			queryParams = append(queryParams, condParams...)
+			params = append(params, condParams...)
 			sb.WriteString(condStr)
 
 			for i := 1; i < len(conditions); i++ {
 				sb.WriteString(" AND ")
-				condStr, condParams, err = conditions[i].Parameterize()
+				condStr, condParams, err = conditions[i].Parameterize(d)
 				if err != nil {
 					return "", nil, fmt.Errorf("failed to parameterize ON condition for %q: %w", joinCond.joinType, err)
 				}
 
-				queryParams = append(queryParams, condParams...)
+				params = append(params, condParams...)
 				sb.WriteString(condStr)
 			}
 			continue
@@ -85,33 +125,134 @@ func (jb joinBuilder) Build() (query string, queryParams []any, err error) {
 
 		return "", nil, fmt.Errorf("invalid join relation type(%T) with %q keyword", joinCond.joinRelation.Relation, joinCond.joinRelation.Keyword)
 	}
+
+	return sb.String(), params, nil
+}
+
+// usingColumnNames returns the column names of a "USING" relation. If the relation is not a "USING" relation,
+// or it doesn't have any column names, then false is returned.
+func usingColumnNames(relation injoin.Relation) ([]string, bool) {
+	if relation.Keyword != "USING" {
+		return nil, false
+	}
+
+	switch columnNames := relation.Relation.(type) {
+	case string:
+		return []string{columnNames}, true
+	case []string:
+		return columnNames, len(columnNames) > 0
+	default:
+		return nil, false
+	}
+}
+
+type joinBuilder struct {
+	selectBuilder selectBuilder
+	joins         []joinCondition
+	errs          intypes.ErrorSlice
+}
+
+func (jb joinBuilder) Build() (query string, queryParams []any, err error) {
+	return finalizeBuild(jb.selectBuilder.dialect, jb)
+}
+
+func (jb joinBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, jb)
+}
+
+func (jb joinBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, jb)
+}
+
+func (jb joinBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, jb)
+}
+
+func (jb joinBuilder) BuildRaw() (query string, queryParams []any, err error) {
+	query, queryParams, err = jb.buildStatement()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return jb.selectBuilder.with.prefix(dialectOrDefault(jb.selectBuilder.dialect), query, queryParams)
+}
+
+// Dialect implements intypes.RawBuilder.
+func (jb joinBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(jb.selectBuilder.dialect)
+}
+
+// buildStatement builds the SELECT statement, along with its joins, without any of its preceding common table expressions
+func (jb joinBuilder) buildStatement() (query string, queryParams []any, err error) {
+	if len(jb.selectBuilder.errs) > 0 {
+		return "", nil, jb.selectBuilder.errs
+	}
+	if len(jb.errs) > 0 {
+		return "", nil, jb.errs
+	}
+
+	d := dialectOrDefault(jb.selectBuilder.dialect)
+	sb := new(strings.Builder)
+
+	// Need to build the select query manually here since `selectBuilder.Build` doesn't produce
+	// the desired string. Mainly, it won't prepend table data if only one table was defined
+	// in `selectBuilder`
+	distinctStr, queryParams, err := jb.selectBuilder.renderDistinct(d)
+	if err != nil {
+		return "", nil, err
+	}
+	columnStr, columnParams, err := inutilities.CoalesceSelectColumnsFullString(d, jb.selectBuilder.columns)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to render selected columns: %w", err)
+	}
+	queryParams = append(queryParams, columnParams...)
+	tableStr, tableParams, err := inutilities.CoalesceTablesString(d, jb.selectBuilder.tables)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to render tables: %w", err)
+	}
+	queryParams = append(queryParams, tableParams...)
+
+	sb.WriteString("SELECT ")
+	sb.WriteString(distinctStr)
+	sb.WriteString(columnStr)
+	sb.WriteString("FROM ")
+	sb.WriteString(tableStr)
+
+	joinStr, joinParams, err := renderJoins(d, jb.joins)
+	if err != nil {
+		return "", nil, err
+	}
+	sb.WriteString(joinStr)
+	queryParams = append(queryParams, joinParams...)
+
 	sb.WriteRune(';')
 
 	return sb.String(), queryParams, nil
 }
 
-func (jb joinBuilder) Join(joinType injoin.Type, table string, joinRelation injoin.Relation, includeColumns ...string) builders.JoinBuilder {
-	tableData, err := tableParser.Parse(table)
+func (jb joinBuilder) Join(joinType injoin.Type, table any, joinRelation injoin.Relation, includeColumns ...any) builders.JoinBuilder {
+	// Copy the slices so that appending to them doesn't affect any other builder sharing the same backing arrays
+	jb.joins = slices.Clone(jb.joins)
+	jb.selectBuilder.columns = slices.Clone(jb.selectBuilder.columns)
+	jb.errs = slices.Clone(jb.errs)
+
+	joinCond, err := newJoinCondition(joinType, table, joinRelation)
 	if err != nil {
-		jb.errs = append(jb.errs, fmt.Errorf("failed to parse table in JOIN clause: %w", err))
+		jb.errs = append(jb.errs, err)
 		return jb
 	}
+	tableData := joinCond.joinTable
 
-	for _, colStr := range includeColumns {
-		columnData, err := selectColumnParser.Parse(colStr)
+	for _, col := range includeColumns {
+		columnData, err := parseSelectColumn(col, &tableData)
 		if err != nil {
-			jb.errs = append(jb.errs, fmt.Errorf("failed to parse column %q in %s of %s: %w", colStr, joinType, tableData.Name, err))
+			jb.errs = append(jb.errs, fmt.Errorf("failed to parse column %q in %s of %s: %w", col, joinType, tableData.Name, err))
 			return jb
 		}
-		columnData.Table = &tableData
 		jb.selectBuilder.columns = append(jb.selectBuilder.columns, columnData)
 	}
 
-	jb.joins = append(jb.joins, joinCondition{
-		joinTable:    tableData,
-		joinType:     joinType,
-		joinRelation: joinRelation,
-	})
+	jb.joins = append(jb.joins, joinCond)
 	return jb
 }
 
@@ -121,6 +262,7 @@ func (jb joinBuilder) Where(condition incondition.Condition, moreConditions ...i
 		conditions: []whereCondition{
 			{condition: condition},
 		},
+		dialect: jb.selectBuilder.dialect,
 	}
 
 	if len(moreConditions) > 0 {
@@ -130,17 +272,77 @@ func (jb joinBuilder) Where(condition incondition.Condition, moreConditions ...i
 	return wb
 }
 
-func (jb joinBuilder) Limit(limit uint) builders.Builder {
+func (jb joinBuilder) SeekAfter(orderings []types.ColumnOrdering, lastValues []any) builders.LimitBuilder {
+	return newSeekBuilder(selectWhereBuilder{mainQuery: jb, dialect: jb.selectBuilder.dialect}, orderings, lastValues)
+}
+
+func (jb joinBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(jb.selectBuilder.dialect, jb)
+}
+
+func (jb joinBuilder) countRows() (builders.ExecutableBuilder, bool) {
+	counted, ok := jb.selectBuilder.countRows()
+	if !ok {
+		return nil, false
+	}
+
+	jb.selectBuilder = counted.(selectBuilder)
+	return jb, true
+}
+
+func (jb joinBuilder) GroupBy(column any, moreColumns ...any) builders.GroupByBuilder {
+	return newGroupByBuilder(jb.selectBuilder.dialect, jb, column, moreColumns...)
+}
+
+func (jb joinBuilder) Window(name string, definition inexpr.Window) builders.WindowBuilder {
+	return newWindowBuilder(jb.selectBuilder.dialect, jb).Window(name, definition)
+}
+
+func (jb joinBuilder) Union(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(jb.selectBuilder.dialect, jb, compoundUnion, query)
+}
+
+func (jb joinBuilder) UnionAll(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(jb.selectBuilder.dialect, jb, compoundUnionAll, query)
+}
+
+func (jb joinBuilder) Intersect(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(jb.selectBuilder.dialect, jb, compoundIntersect, query)
+}
+
+func (jb joinBuilder) Except(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(jb.selectBuilder.dialect, jb, compoundExcept, query)
+}
+
+func (jb joinBuilder) Limit(limit uint) builders.LockableBuilder {
 	return limitBuilder{
 		precedingBuilder: jb,
 		limit:            limit,
+		dialect:          jb.selectBuilder.dialect,
 	}
 }
 
+func (jb joinBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(jb.selectBuilder.dialect, jb).ForUpdate(tables...)
+}
+
+func (jb joinBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(jb.selectBuilder.dialect, jb).ForNoKeyUpdate(tables...)
+}
+
+func (jb joinBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(jb.selectBuilder.dialect, jb).ForShare(tables...)
+}
+
+func (jb joinBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(jb.selectBuilder.dialect, jb).ForKeyShare(tables...)
+}
+
 func (jb joinBuilder) Offset(offset uint) builders.LimitBuilder {
 	return offsetBuilder{
 		precedingBuilder: jb,
 		offset:           offset,
+		dialect:          jb.selectBuilder.dialect,
 	}
 }
 
@@ -148,5 +350,6 @@ func (jb joinBuilder) OrderBy(ordering types.ColumnOrdering, moreOrderings ...ty
 	return orderByBuilder{
 		precedingBuilder: jb,
 		columnOrderings:  append([]types.ColumnOrdering{ordering}, moreOrderings...),
+		dialect:          jb.selectBuilder.dialect,
 	}
 }
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/williabk198/jagsqlb/builders"
 	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
 	injoin "github.com/williabk198/jagsqlb/internal/join"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 	"github.com/williabk198/jagsqlb/join"
@@ -165,7 +167,7 @@ func Test_joinBuilder_Join(t *testing.T) {
 		joinType       injoin.Type
 		table          string
 		joinRelation   injoin.Relation
-		includeColumns []string
+		includeColumns []any
 	}
 
 	testTable1 := intypes.Table{
@@ -206,7 +208,7 @@ func Test_joinBuilder_Join(t *testing.T) {
 		joinType:  join.TypeRight,
 		joinRelation: injoin.Relation{
 			Keyword:  "USING",
-			Relation: "col2",
+			Relation: []string{"col2"},
 		},
 	}
 
@@ -245,7 +247,7 @@ func Test_joinBuilder_Join(t *testing.T) {
 				joinType:       join.TypeRight,
 				table:          "table2 AS t2",
 				joinRelation:   join.Using("col2"),
-				includeColumns: []string{"col3"},
+				includeColumns: []any{"col3"},
 			},
 			want: joinBuilder{
 				selectBuilder: selectBuilder{
@@ -292,7 +294,7 @@ func Test_joinBuilder_Join(t *testing.T) {
 					Keyword:  "USING",
 					Relation: "col1",
 				},
-				includeColumns: []string{".bad_col"},
+				includeColumns: []any{".bad_col"},
 			},
 			want: joinBuilder{
 				selectBuilder: selectBuilder{},
@@ -433,3 +435,117 @@ func Test_joinBuilder_OrderBy(t *testing.T) {
 		})
 	}
 }
+
+func Test_joinBuilder_JoinTypes(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	latestOrder := NewSelectBuilder(nil, "orders", "total").Where(condition.Equals("customer_id", condition.ColumnValue("c.id"))).
+		OrderBy(types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingDescending}).Limit(1)
+	lateral := intypes.Table{Alias: "l", SubQuery: inexpr.Subquery{Builder: latestOrder}, Lateral: true}
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Success; Full Outer",
+			builder: NewSelectBuilder(nil, "a", "*").Join(join.TypeFull, "b", join.On(condition.Equals("a.id", condition.ColumnValue("b.id"))), "*"),
+			wants: wants{
+				query: `SELECT "a".*, "b".* FROM "a" FULL OUTER JOIN "b" ON "a"."id" = "b"."id";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Deprecated Outer",
+			builder: NewSelectBuilder(nil, "a", "*").Join(join.TypeOuter, "b", join.Using("id")),
+			wants: wants{
+				query: `SELECT "a".* FROM "a" FULL OUTER JOIN "b" USING ("id");`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Using Multiple Columns",
+			builder: NewSelectBuilder(nil, "a", "*").Join(join.TypeInner, "b", join.Using("tenant_id", "id")),
+			wants: wants{
+				query: `SELECT "a".* FROM "a" INNER JOIN "b" USING ("tenant_id", "id");`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Cross",
+			builder: NewSelectBuilder(nil, "sizes", "name").Join(join.TypeCross, "colors", join.None(), "name"),
+			wants: wants{
+				query: `SELECT "sizes"."name", "colors"."name" FROM "sizes" CROSS JOIN "colors";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Natural Joins",
+			builder: NewSelectBuilder(dialect.SQLite, "a", "*").
+				Join(join.TypeNatural, "b", join.None()).
+				Join(join.TypeNaturalLeft, "c", join.None()).
+				Join(join.TypeNaturalRight, "d", join.None()).
+				Join(join.TypeNaturalFull, "e", join.None()),
+			wants: wants{
+				query: `SELECT "a".* FROM "a" NATURAL JOIN "b" NATURAL LEFT JOIN "c" NATURAL RIGHT JOIN "d" NATURAL FULL OUTER JOIN "e";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Cross Lateral",
+			builder: NewSelectBuilder(nil, "customers AS c", "name").Join(join.TypeCross, lateral, join.None(), "total"),
+			wants: wants{
+				query: `SELECT "c"."name", "l"."total" FROM "customers" AS "c" CROSS JOIN LATERAL (SELECT "total" FROM "orders" ` +
+					`WHERE "customer_id" = "c"."id" ORDER BY "created_at" DESC LIMIT 1) AS "l";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Left Lateral w/o Relation",
+			builder: NewSelectBuilder(nil, "customers AS c", "name").Join(join.TypeLeft, lateral, join.None(), "total"),
+			wants: wants{
+				query: `SELECT "c"."name", "l"."total" FROM "customers" AS "c" LEFT JOIN LATERAL (SELECT "total" FROM "orders" ` +
+					`WHERE "customer_id" = "c"."id" ORDER BY "created_at" DESC LIMIT 1) AS "l" ON TRUE;`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Cross w/ Relation",
+			builder:   NewSelectBuilder(nil, "sizes", "*").Join(join.TypeCross, "colors", join.Using("id")),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Natural w/ Relation",
+			builder:   NewSelectBuilder(nil, "a", "*").Join(join.TypeNatural, "b", join.On(condition.Equals("a.id", condition.ColumnValue("b.id")))),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Inner w/o Relation",
+			builder:   NewSelectBuilder(nil, "a", "*").Join(join.TypeInner, "b", join.None()),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Full Outer Unsupported",
+			builder:   NewSelectBuilder(dialect.MySQL, "a", "*").Join(join.TypeFull, "b", join.Using("id")),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Natural Unsupported",
+			builder:   NewSelectBuilder(dialect.SQLServer, "a", "*").Join(join.TypeNatural, "b", join.None()),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
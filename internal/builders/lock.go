@@ -0,0 +1,204 @@
+package inbuilders
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+// rowLock is a single locking clause, such as "FOR UPDATE OF "t1" SKIP LOCKED"
+type rowLock struct {
+	clause   dialect.Clause
+	tables   []intypes.Table
+	modifier string // Either "SKIP LOCKED", "NOWAIT" or empty
+}
+
+// rowLockBuilder implements `builders.RowLockBuilder` and represents the locking clauses at the end of a SELECT statement
+type rowLockBuilder struct {
+	precedingBuilder builders.Builder
+	locks            []rowLock
+	dialect          dialect.Dialect
+	errs             intypes.ErrorSlice
+}
+
+func (rlb rowLockBuilder) Build() (string, []any, error) {
+	return finalizeBuild(rlb.dialect, rlb)
+}
+
+func (rlb rowLockBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, rlb)
+}
+
+func (rlb rowLockBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, rlb)
+}
+
+func (rlb rowLockBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, rlb)
+}
+
+func (rlb rowLockBuilder) BuildRaw() (string, []any, error) {
+	if len(rlb.errs) > 0 {
+		return "", nil, rlb.errs
+	}
+
+	query, params, err := buildRaw(rlb.precedingBuilder)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build preceding query: %w", err)
+	}
+
+	if clause := unlockableClauseOf(rlb.precedingBuilder); clause != "" {
+		return "", nil, fmt.Errorf("rows can't be locked by a query that uses %s", clause)
+	}
+
+	d := dialectOrDefault(rlb.dialect)
+	sb := new(strings.Builder)
+	sb.WriteString(query[:len(query)-1])
+
+	for _, lock := range rlb.locks {
+		if !d.Supports(lock.clause) {
+			return "", nil, intypes.NewUnsupportedClauseError(d, lock.clause)
+		}
+
+		sb.WriteRune(' ')
+		sb.WriteString(string(lock.clause))
+
+		for i, table := range lock.tables {
+			if i == 0 {
+				sb.WriteString(" OF ")
+			} else {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(table.RenderReference(d))
+		}
+
+		if lock.modifier != "" {
+			sb.WriteRune(' ')
+			sb.WriteString(lock.modifier)
+		}
+	}
+	sb.WriteRune(';')
+
+	return sb.String(), params, nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (rlb rowLockBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(rlb.dialect)
+}
+
+func (rlb rowLockBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(rlb.dialect, rlb)
+}
+
+func (rlb rowLockBuilder) countRows() (builders.ExecutableBuilder, bool) {
+	if len(rlb.errs) > 0 {
+		return nil, false
+	}
+	return newCountBuilder(rlb.dialect, rlb.precedingBuilder), true
+}
+
+func (rlb rowLockBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return rlb.lock(dialect.ClauseForUpdate, tables)
+}
+
+func (rlb rowLockBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return rlb.lock(dialect.ClauseForNoKeyUpdate, tables)
+}
+
+func (rlb rowLockBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return rlb.lock(dialect.ClauseForShare, tables)
+}
+
+func (rlb rowLockBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return rlb.lock(dialect.ClauseForKeyShare, tables)
+}
+
+func (rlb rowLockBuilder) SkipLocked() builders.LockableBuilder {
+	return rlb.modify("SKIP LOCKED")
+}
+
+func (rlb rowLockBuilder) NoWait() builders.LockableBuilder {
+	return rlb.modify("NOWAIT")
+}
+
+// lock adds a locking clause that only applies to the provided tables, or to every table if none are provided.
+// The locking clause is added even if a table fails to parse, so that any modifier that follows it has a clause to modify.
+func (rlb rowLockBuilder) lock(clause dialect.Clause, tables []string) rowLockBuilder {
+	lock := rowLock{clause: clause}
+	for _, table := range tables {
+		tableData, err := tableParser.Parse(table)
+		if err != nil {
+			rlb.errs = append(slices.Clone(rlb.errs), fmt.Errorf("failed to parse table to lock in %s: %w", clause, err))
+			continue
+		}
+		lock.tables = append(lock.tables, tableData)
+	}
+
+	// Copy the locks so that appending to them doesn't affect any other builder sharing the same backing array
+	rlb.locks = append(slices.Clone(rlb.locks), lock)
+	return rlb
+}
+
+// modify sets how the most recently added locking clause handles rows that are already locked
+func (rlb rowLockBuilder) modify(modifier string) rowLockBuilder {
+	rlb.locks = slices.Clone(rlb.locks)
+	rlb.locks[len(rlb.locks)-1].modifier = modifier
+	return rlb
+}
+
+// unlockableClauseOf returns the clause of the statement being locked that prevents its rows from being locked,
+// since its rows don't correspond to individual table rows. An empty string is returned if its rows can be locked.
+func unlockableClauseOf(b builders.Builder) string {
+	switch b := b.(type) {
+	case selectBuilder:
+		switch {
+		case b.distinct:
+			return "DISTINCT"
+		case len(b.distinctOn) > 0:
+			return "DISTINCT ON"
+		}
+		for _, column := range b.columns {
+			if _, ok := column.Expression.(inexpr.WindowFunction); ok {
+				return "window functions"
+			}
+		}
+		return ""
+	case joinBuilder:
+		return unlockableClauseOf(b.selectBuilder)
+	case selectWhereBuilder:
+		return unlockableClauseOf(b.mainQuery)
+	case groupByBuilder, havingBuilder:
+		return "GROUP BY"
+	case windowBuilder:
+		return unlockableClauseOf(b.precedingBuilder)
+	case compoundBuilder:
+		if len(b.operands) > 0 {
+			return b.operands[0].operator
+		}
+		return unlockableClauseOf(b.firstQuery)
+	case orderByBuilder:
+		return unlockableClauseOf(b.precedingBuilder)
+	case offsetBuilder:
+		return unlockableClauseOf(b.precedingBuilder)
+	case limitBuilder:
+		return unlockableClauseOf(b.precedingBuilder)
+	default:
+		return ""
+	}
+}
+
+// newRowLockBuilder creates a rowLockBuilder, without any locking clauses, that follows `precedingBuilder`
+func newRowLockBuilder(d dialect.Dialect, precedingBuilder builders.Builder) rowLockBuilder {
+	return rowLockBuilder{
+		precedingBuilder: precedingBuilder,
+		dialect:          d,
+	}
+}
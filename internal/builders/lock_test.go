@@ -0,0 +1,167 @@
+package inbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/expr"
+	"github.com/williabk198/jagsqlb/join"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+func Test_rowLockBuilder_Build(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	createdAt := types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingAscending}
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Job Queue",
+			builder: NewSelectBuilder(nil, "jobs", "*").Where(condition.Equals("status", "pending")).
+				OrderBy(createdAt).Limit(1).ForUpdate().SkipLocked(),
+			wants: wants{
+				query:  `SELECT * FROM "jobs" WHERE "status" = $1 ORDER BY "created_at" ASC LIMIT 1 FOR UPDATE SKIP LOCKED;`,
+				params: []any{"pending"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Select",
+			builder: NewSelectBuilder(nil, "jobs", "*").ForShare(),
+			wants: wants{
+				query: `SELECT * FROM "jobs" FOR SHARE;`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Multiple Locks w/ Tables",
+			builder: NewSelectBuilder(nil, "orders AS o", "*").
+				Join(join.TypeInner, "customers AS c", join.Using("customer_id")).
+				Where(condition.Equals("c.id", 7)).
+				Offset(5).
+				ForNoKeyUpdate("o").NoWait().
+				ForKeyShare("c", "public.regions"),
+			wants: wants{
+				query: `SELECT "o".* FROM "orders" AS "o" INNER JOIN "customers" AS "c" USING ("customer_id") WHERE "c"."id" = $1 OFFSET 5 ` +
+					`FOR NO KEY UPDATE OF "o" NOWAIT FOR KEY SHARE OF "c", "public"."regions";`,
+				params: []any{7},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; MySQL",
+			builder: NewSelectBuilder(dialect.MySQL, "jobs", "*").Offset(20).Limit(10).ForUpdate("jobs").NoWait(),
+			wants: wants{
+				query: "SELECT * FROM `jobs` LIMIT 10 OFFSET 20 FOR UPDATE OF `jobs` NOWAIT;",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Unsupported by Dialect",
+			builder:   NewSelectBuilder(dialect.SQLite, "jobs", "*").ForUpdate(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Lock Strength Unsupported by Dialect",
+			builder:   NewSelectBuilder(dialect.MySQL, "jobs", "*").ForKeyShare(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Table",
+			builder:   NewSelectBuilder(nil, "jobs", "*").ForUpdate(".jobs"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Table w/ Skip Locked",
+			builder:   NewSelectBuilder(nil, "jobs", "*").ForUpdate(".jobs").SkipLocked(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Table w/ No Wait",
+			builder:   NewSelectBuilder(nil, "jobs", "*").ForShare("jobs", ".jobs").NoWait(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Union",
+			builder:   NewSelectBuilder(nil, "jobs", "*").Union(NewSelectBuilder(nil, "archived_jobs", "*")).ForUpdate(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Ordered Intersect",
+			builder:   NewSelectBuilder(nil, "jobs", "id").Intersect(NewSelectBuilder(nil, "claims", "job_id")).OrderBy(createdAt).Limit(1).ForShare(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Group By",
+			builder:   NewSelectBuilder(nil, "jobs", "status").Where(condition.Equals("queue", "default")).GroupBy("status").ForUpdate(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Having",
+			builder:   NewSelectBuilder(nil, "jobs", "status").GroupBy("status").Having(condition.GreaterThan(expr.Count("*"), 1)).ForShare(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Distinct",
+			builder:   NewSelectBuilder(nil, "jobs", "queue").Distinct().ForUpdate(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Distinct On w/ Join",
+			builder:   NewSelectBuilder(nil, "jobs AS j", "*").DistinctOn("j.queue").Join(join.TypeInner, "queues AS q", join.Using("queue")).ForUpdate(),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Window Function",
+			builder: NewSelectBuilder(nil, "jobs", "id", expr.RowNumber().Over(expr.PartitionBy("queue")).As("n")).
+				Where(condition.Equals("status", "queued")).Limit(10).ForUpdate(),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Named Window w/ Join",
+			builder: NewSelectBuilder(nil, "jobs AS j", "j.id", expr.Rank().Over(expr.NamedWindow("w"))).
+				Join(join.TypeInner, "queues AS q", join.Using("queue")).Window("w", expr.PartitionBy("q.name")).ForShare(),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Preceding Builder",
+			builder:   NewSelectBuilder(nil, ".jobs", "*").ForUpdate(),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func Test_rowLockBuilder_SkipLocked(t *testing.T) {
+	base := NewSelectBuilder(nil, "jobs", "*").ForUpdate()
+
+	// Modifying the same lock multiple times should not cause the results to affect each other
+	first, _, err := base.SkipLocked().Build()
+	assert.NoError(t, err)
+	second, _, err := base.NoWait().Build()
+	assert.NoError(t, err)
+	unmodified, _, err := base.Build()
+	assert.NoError(t, err)
+
+	assert.Equal(t, `SELECT * FROM "jobs" FOR UPDATE SKIP LOCKED;`, first)
+	assert.Equal(t, `SELECT * FROM "jobs" FOR UPDATE NOWAIT;`, second)
+	assert.Equal(t, `SELECT * FROM "jobs" FOR UPDATE;`, unmodified)
+}
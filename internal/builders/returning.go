@@ -1,38 +1,64 @@
 package inbuilders
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
 type returningBuilder struct {
 	prevBuilder      builders.Builder
 	returningColumns []intypes.Column
+	dialect          dialect.Dialect
 	errs             intypes.ErrorSlice
 }
 
 func (rb returningBuilder) Build() (string, []any, error) {
+	return finalizeBuild(rb.dialect, rb)
+}
+
+func (rb returningBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, rb)
+}
+
+func (rb returningBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, rb)
+}
+
+func (rb returningBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, rb)
+}
+
+func (rb returningBuilder) BuildRaw() (string, []any, error) {
 	if len(rb.errs) > 0 {
 		return "", nil, rb.errs
 	}
 
-	query, params, err := rb.prevBuilder.Build()
+	query, params, err := buildRaw(rb.prevBuilder)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to build section before the returning builder: %w", err)
 	}
 
+	d := dialectOrDefault(rb.dialect)
 	sb := new(strings.Builder)
 	sb.WriteString(query[:len(query)-1])
 
 	if len(rb.returningColumns) > 0 {
+		if !d.Supports(dialect.ClauseReturning) {
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseReturning)
+		}
+
 		sb.WriteString(" RETURNING ")
-		sb.WriteString(rb.returningColumns[0].String())
+		sb.WriteString(rb.returningColumns[0].Render(d))
 		for i := 1; i < len(rb.returningColumns); i++ {
 			sb.WriteString(", ")
-			sb.WriteString(rb.returningColumns[i].String())
+			sb.WriteString(rb.returningColumns[i].Render(d))
 		}
 	}
 	sb.WriteRune(';')
@@ -40,7 +66,16 @@ func (rb returningBuilder) Build() (string, []any, error) {
 	return sb.String(), params, nil
 }
 
-func (rb returningBuilder) Returning(column string, moreColumns ...string) builders.Builder {
+// Dialect implements intypes.RawBuilder.
+func (rb returningBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(rb.dialect)
+}
+
+func (rb returningBuilder) Returning(column string, moreColumns ...string) builders.ExecutableBuilder {
+	// Copy the slices so that appending to them doesn't affect any other builder sharing the same backing arrays
+	rb.returningColumns = slices.Clone(rb.returningColumns)
+	rb.errs = slices.Clone(rb.errs)
+
 	col, err := columnParser.Parse(column)
 	if err != nil {
 		rb.errs = append(rb.errs, err)
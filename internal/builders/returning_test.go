@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/williabk198/jagsqlb/builders"
 	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
@@ -25,7 +26,7 @@ func Test_returningBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Single Column",
 			rb: returningBuilder{
-				prevBuilder: NewDeleteBuilder("table1").Where(condition.Equals("col1", "val")),
+				prevBuilder: NewDeleteBuilder(nil, "table1").Where(condition.Equals("col1", "val")),
 				returningColumns: []intypes.Column{
 					{Name: "*"},
 				},
@@ -39,7 +40,7 @@ func Test_returningBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Multiple Column",
 			rb: returningBuilder{
-				prevBuilder: NewDeleteBuilder("table1").Where(condition.GreaterThan("col2", 52)),
+				prevBuilder: NewDeleteBuilder(nil, "table1").Where(condition.GreaterThan("col2", 52)),
 				returningColumns: []intypes.Column{
 					{Name: "col1"},
 					{Name: "col2"},
@@ -51,10 +52,26 @@ func Test_returningBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; Insert From Select",
+			rb: returningBuilder{
+				prevBuilder: NewInsertBuilder(nil, "archive").Columns("id").FromSelect(
+					NewSelectBuilder(nil, "orders", "id").Where(condition.LessThan("placed_at", 100)),
+				),
+				returningColumns: []intypes.Column{
+					{Name: "id"},
+				},
+			},
+			wants: wants{
+				query:  `INSERT INTO "archive" ("id") SELECT "id" FROM "orders" WHERE "placed_at" < $1 RETURNING "id";`,
+				params: []any{100},
+			},
+			assertion: assert.NoError,
+		},
 		{
 			name: "Success; No Columns Provided",
 			rb: returningBuilder{
-				prevBuilder:      NewInsertBuilder("table1").Data(struct{ Data string }{"test"}),
+				prevBuilder:      NewInsertBuilder(nil, "table1").Data(struct{ Data string }{"test"}),
 				returningColumns: []intypes.Column{},
 			},
 			wants: wants{
@@ -63,6 +80,32 @@ func Test_returningBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; SQLite",
+			rb: returningBuilder{
+				prevBuilder: NewDeleteBuilder(dialect.SQLite, "table1").Where(condition.GreaterThan("col2", 52)),
+				returningColumns: []intypes.Column{
+					{Name: "col1"},
+				},
+				dialect: dialect.SQLite,
+			},
+			wants: wants{
+				query:  `DELETE FROM "table1" WHERE "col2" > ? RETURNING "col1";`,
+				params: []any{52},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Unsupported by Dialect",
+			rb: returningBuilder{
+				prevBuilder: NewDeleteBuilder(dialect.MySQL, "table1"),
+				returningColumns: []intypes.Column{
+					{Name: "col1"},
+				},
+				dialect: dialect.MySQL,
+			},
+			assertion: assert.Error,
+		},
 		{
 			name: "Error; ErrorSlice not Empty",
 			rb: returningBuilder{
@@ -73,7 +116,7 @@ func Test_returningBuilder_Build(t *testing.T) {
 		{
 			name: "Error; Previous Build Error",
 			rb: returningBuilder{
-				prevBuilder: NewDeleteBuilder(".table2"),
+				prevBuilder: NewDeleteBuilder(nil, ".table2"),
 			},
 			assertion: assert.Error,
 		},
@@ -0,0 +1,243 @@
+package inbuilders
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+// seekCondition is the condition that limits a result set to the rows that come after `lastValues`
+// when the result set is sorted by `orderings`
+type seekCondition struct {
+	orderings  []types.ColumnOrdering
+	lastValues []any
+}
+
+func (sc seekCondition) Parameterize(d dialect.Dialect) (string, []any, error) {
+	if sc.canCompareRowValues(d) {
+		return sc.rowValueComparison(d)
+	}
+
+	var disjuncts []string
+	var params []any
+	for i := range sc.orderings {
+		var terms []string
+		var termParams []any
+
+		// Every preceding column must be equal to its last value...
+		for j := range i {
+			term, param, err := sc.renderTerm(d, j, "=")
+			if err != nil {
+				return "", nil, err
+			}
+			terms = append(terms, term)
+			termParams = append(termParams, param...)
+		}
+
+		// ...and the current column must come after its last value
+		term, param, err := sc.renderAfter(d, i)
+		if err != nil {
+			return "", nil, err
+		}
+		if term == "" {
+			// Nothing comes after a NULL value that is placed last, so there is nothing to match
+			continue
+		}
+		terms = append(terms, term)
+		termParams = append(termParams, param...)
+
+		if len(terms) > 1 {
+			disjuncts = append(disjuncts, "("+strings.Join(terms, " AND ")+")")
+		} else {
+			disjuncts = append(disjuncts, terms[0])
+		}
+		params = append(params, termParams...)
+	}
+
+	switch len(disjuncts) {
+	case 0:
+		return "1 = 0", nil, nil
+	case 1:
+		return disjuncts[0], params, nil
+	}
+	return "(" + strings.Join(disjuncts, " OR ") + ")", params, nil
+}
+
+// canCompareRowValues checks to see if the condition can be expressed as a single row value comparison.
+// e.g. `("a", "b") > (?, ?)`
+func (sc seekCondition) canCompareRowValues(d dialect.Dialect) bool {
+	if len(sc.orderings) > 1 && !d.Supports(dialect.ClauseRowValues) {
+		return false
+	}
+
+	for i, ordering := range sc.orderings {
+		if ordering.Nulls != "" || isNullValue(sc.lastValues[i]) || seekOperator(ordering) != seekOperator(sc.orderings[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// rowValueComparison renders the condition as a single row value comparison
+func (sc seekCondition) rowValueComparison(d dialect.Dialect) (string, []any, error) {
+	columns := make([]string, len(sc.orderings))
+	placeholders := make([]string, len(sc.orderings))
+	var params []any
+	for i, ordering := range sc.orderings {
+		columnStr, columnParams, err := ordering.ParameterizeColumn(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parameterize seek column: %w", err)
+		}
+		columns[i] = columnStr
+		placeholders[i] = "?"
+		params = append(params, columnParams...)
+	}
+	params = append(params, sc.lastValues...)
+
+	if len(columns) == 1 {
+		return fmt.Sprintf("%s %s ?", columns[0], seekOperator(sc.orderings[0])), params, nil
+	}
+	return fmt.Sprintf(
+		"(%s) %s (%s)", strings.Join(columns, ", "), seekOperator(sc.orderings[0]), strings.Join(placeholders, ", "),
+	), params, nil
+}
+
+// renderAfter renders the term that matches the rows whose value for the ordering at `index` comes after its last value.
+// An empty string is returned if no value can come after it.
+func (sc seekCondition) renderAfter(d dialect.Dialect, index int) (string, []any, error) {
+	ordering := sc.orderings[index]
+	if isNullValue(sc.lastValues[index]) {
+		// The placement of NULL values is validated by `validateSeek`
+		if ordering.Nulls == types.NullsFirst {
+			return sc.renderTerm(d, index, "IS NOT NULL")
+		}
+		return "", nil, nil
+	}
+
+	term, params, err := sc.renderTerm(d, index, seekOperator(ordering))
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Any NULL values that are placed last also come after the last value
+	if ordering.Nulls == types.NullsLast {
+		nullTerm, nullParams, err := sc.renderTerm(d, index, "IS NULL")
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("(%s OR %s)", term, nullTerm), append(params, nullParams...), nil
+	}
+	return term, params, nil
+}
+
+// renderTerm renders the column of the ordering at `index` followed by the provided operator. Unless the operator
+// checks for NULL, it is followed by a placeholder for the last value. An "=" operator is changed to "IS NULL" for NULL values.
+func (sc seekCondition) renderTerm(d dialect.Dialect, index int, operator string) (string, []any, error) {
+	columnStr, params, err := sc.orderings[index].ParameterizeColumn(d)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parameterize seek column: %w", err)
+	}
+
+	value := sc.lastValues[index]
+	if operator == "=" && isNullValue(value) {
+		operator = "IS NULL"
+	}
+
+	if strings.HasSuffix(operator, "NULL") {
+		return fmt.Sprintf("%s %s", columnStr, operator), params, nil
+	}
+	return fmt.Sprintf("%s %s ?", columnStr, operator), append(slices.Clone(params), value), nil
+}
+
+// isNullValue checks to see if the provided value will be sent to the database as NULL
+func isNullValue(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(value)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+// seekOperator returns the operator that matches the values which come after another value with the provided ordering
+func seekOperator(ordering types.ColumnOrdering) string {
+	if ordering.Ordering == types.OrderingDescending {
+		return "<"
+	}
+	return ">"
+}
+
+// validateSeek checks that the rows can be sought after `lastValues` when they are sorted by `orderings`.
+// The dialect is used to describe any ordering that is at fault.
+func validateSeek(d dialect.Dialect, orderings []types.ColumnOrdering, lastValues []any) error {
+	switch {
+	case len(orderings) == 0:
+		return fmt.Errorf("at least one ordering must be provided to seek with")
+	case len(lastValues) > 0 && len(lastValues) != len(orderings):
+		return fmt.Errorf("received %d last values to seek after, but there are %d orderings", len(lastValues), len(orderings))
+	}
+
+	for i, ordering := range orderings {
+		// A position only refers to a column within the "ORDER BY" clause, so it would be compared as a constant
+		if ordering.Position > 0 && ordering.Expression == nil {
+			return fmt.Errorf("ordering %d refers to a column position, which can't be used to seek with", i)
+		}
+
+		if len(lastValues) > 0 && isNullValue(lastValues[i]) && ordering.Nulls == "" {
+			column, _, err := ordering.ParameterizeColumn(d)
+			if err != nil {
+				return fmt.Errorf("failed to parameterize seek column: %w", err)
+			}
+			return fmt.Errorf("the placement of NULL values must be set on the ordering of %s in order to seek after a NULL value", column)
+		}
+	}
+	return nil
+}
+
+// withoutSeek returns a copy of `w` without the condition added by `SeekAfter`
+func (w selectWhereBuilder) withoutSeek() selectWhereBuilder {
+	conditions := make(whereConditions, 0, len(w.conditions))
+	for _, cond := range w.conditions {
+		if _, ok := cond.condition.(seekCondition); !ok {
+			conditions.Append(cond)
+		}
+	}
+	w.conditions = conditions
+	return w
+}
+
+// newSeekBuilder adds the condition used by `SeekAfter` to the conditions of `w`, and sorts the result set by `orderings`.
+// If there are no last values, then the first page is being requested. In which case, no condition is added so that
+// every row is matched.
+func newSeekBuilder(w selectWhereBuilder, orderings []types.ColumnOrdering, lastValues []any) orderByBuilder {
+	obb := orderByBuilder{
+		precedingBuilder: w,
+		columnOrderings:  slices.Clone(orderings),
+		dialect:          w.dialect,
+	}
+
+	if err := validateSeek(dialectOrDefault(w.dialect), orderings, lastValues); err != nil {
+		obb.errs = append(obb.errs, err)
+		return obb
+	}
+	if len(lastValues) == 0 {
+		return obb
+	}
+
+	// Since "AND" takes precedence over "OR", the existing conditions need to be grouped together if any of them use "OR".
+	// Otherwise, the seek condition would only apply to the conditions after the last "OR".
+	w.conditions = slices.Clone(w.conditions.grouped())
+	w.conditions.Append(whereCondition{
+		conjunction: "AND",
+		condition: seekCondition{
+			orderings:  obb.columnOrderings,
+			lastValues: slices.Clone(lastValues),
+		},
+	})
+	obb.precedingBuilder = w
+	return obb
+}
@@ -0,0 +1,240 @@
+package inbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/expr"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	"github.com/williabk198/jagsqlb/join"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+func Test_seekBuilder_Build(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	createdDesc := types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingDescending}
+	idDesc := types.ColumnOrdering{ColumnName: "id", Ordering: types.OrderingDescending}
+	idAsc := types.ColumnOrdering{ColumnName: "id", Ordering: types.OrderingAscending}
+	nameAsc := types.ColumnOrdering{ColumnName: "name", Ordering: types.OrderingAscending}
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Success; First Page",
+			builder: NewSelectBuilder(nil, "posts", "*").SeekAfter([]types.ColumnOrdering{createdDesc, idDesc}, nil).Limit(20),
+			wants: wants{
+				query: `SELECT * FROM "posts" ORDER BY "created_at" DESC, "id" DESC LIMIT 20;`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Row Values",
+			builder: NewSelectBuilder(nil, "posts", "*").Where(condition.Equals("author_id", 7)).
+				SeekAfter([]types.ColumnOrdering{createdDesc, idDesc}, []any{"2025-01-01", 42}).Limit(20),
+			wants: wants{
+				query:  `SELECT * FROM "posts" WHERE "author_id" = $1 AND ("created_at", "id") < ($2, $3) ORDER BY "created_at" DESC, "id" DESC LIMIT 20;`,
+				params: []any{7, "2025-01-01", 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Single Column",
+			builder: NewSelectBuilder(nil, "posts", "*").SeekAfter([]types.ColumnOrdering{idAsc}, []any{42}),
+			wants: wants{
+				query:  `SELECT * FROM "posts" WHERE "id" > $1 ORDER BY "id" ASC;`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Mixed Orderings",
+			builder: NewSelectBuilder(nil, "users", "*").
+				SeekAfter([]types.ColumnOrdering{nameAsc, createdDesc, idAsc}, []any{"bob", "2025-01-01", 42}).Limit(10),
+			wants: wants{
+				query: `SELECT * FROM "users" WHERE ("name" > $1 OR ("name" = $2 AND "created_at" < $3) OR ` +
+					`("name" = $4 AND "created_at" = $5 AND "id" > $6)) ORDER BY "name" ASC, "created_at" DESC, "id" ASC LIMIT 10;`,
+				params: []any{"bob", "bob", "2025-01-01", "bob", "2025-01-01", 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Nulls Last",
+			builder: NewSelectBuilder(nil, "users", "*").SeekAfter(
+				[]types.ColumnOrdering{{ColumnName: "nickname", Ordering: types.OrderingAscending, Nulls: types.NullsLast}, idAsc},
+				[]any{"bob", 42},
+			),
+			wants: wants{
+				query: `SELECT * FROM "users" WHERE (("nickname" > $1 OR "nickname" IS NULL) OR ("nickname" = $2 AND "id" > $3)) ` +
+					`ORDER BY "nickname" ASC NULLS LAST, "id" ASC;`,
+				params: []any{"bob", "bob", 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; After NULL w/ Nulls Last",
+			builder: NewSelectBuilder(nil, "users", "*").SeekAfter(
+				[]types.ColumnOrdering{{ColumnName: "nickname", Ordering: types.OrderingAscending, Nulls: types.NullsLast}, idAsc},
+				[]any{nil, 42},
+			),
+			wants: wants{
+				query:  `SELECT * FROM "users" WHERE ("nickname" IS NULL AND "id" > $1) ORDER BY "nickname" ASC NULLS LAST, "id" ASC;`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; After NULL w/ Nulls First",
+			builder: NewSelectBuilder(nil, "users", "*").SeekAfter(
+				[]types.ColumnOrdering{{ColumnName: "nickname", Ordering: types.OrderingDescending, Nulls: types.NullsFirst}, idAsc},
+				[]any{(*string)(nil), 42},
+			),
+			wants: wants{
+				query: `SELECT * FROM "users" WHERE ("nickname" IS NOT NULL OR ("nickname" IS NULL AND "id" > $1)) ` +
+					`ORDER BY "nickname" DESC NULLS FIRST, "id" ASC;`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Where w/ Or",
+			builder: NewSelectBuilder(nil, "posts", "*").Where(condition.Equals("a", 1), condition.Equals("b", 2)).
+				Or(condition.Equals("c", 3)).SeekAfter([]types.ColumnOrdering{idAsc}, []any{42}),
+			wants: wants{
+				query:  `SELECT * FROM "posts" WHERE (("a" = $1 AND "b" = $2) OR "c" = $3) AND "id" > $4 ORDER BY "id" ASC;`,
+				params: []any{1, 2, 3, 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Join w/ Expression",
+			builder: NewSelectBuilder(nil, "posts AS p", "*").
+				Join(join.TypeInner, "users AS u", join.Using("user_id")).
+				SeekAfter(
+					[]types.ColumnOrdering{
+						{Expression: inexpr.Function{Name: "LOWER", Args: []any{"u.name"}}, Ordering: types.OrderingAscending},
+						{ColumnName: "p.id", Ordering: types.OrderingAscending},
+					},
+					[]any{"bob", 42},
+				),
+			wants: wants{
+				query: `SELECT "p".* FROM "posts" AS "p" INNER JOIN "users" AS "u" USING ("user_id") ` +
+					`WHERE (LOWER("u"."name"), "p"."id") > ($1, $2) ORDER BY LOWER("u"."name") ASC, "p"."id" ASC;`,
+				params: []any{"bob", 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; SQL Server",
+			builder: NewSelectBuilder(dialect.SQLServer, "posts", "*").SeekAfter([]types.ColumnOrdering{createdDesc, idDesc}, []any{"2025-01-01", 42}),
+			wants: wants{
+				query:  `SELECT * FROM [posts] WHERE ([created_at] < @p1 OR ([created_at] = @p2 AND [id] < @p3)) ORDER BY [created_at] DESC, [id] DESC;`,
+				params: []any{"2025-01-01", "2025-01-01", 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL w/ Emulated Nulls and Collation",
+			builder: NewSelectBuilder(dialect.MySQL, "users", "*").SeekAfter(
+				[]types.ColumnOrdering{types.Asc("nickname").Collate("utf8mb4_bin").NullsLast(), idAsc},
+				[]any{"bob", 42},
+			),
+			wants: wants{
+				query: "SELECT * FROM `users` WHERE ((`nickname` COLLATE `utf8mb4_bin` > ? OR `nickname` COLLATE `utf8mb4_bin` IS NULL) OR " +
+					"(`nickname` COLLATE `utf8mb4_bin` = ? AND `id` > ?)) ORDER BY CASE WHEN `nickname` COLLATE `utf8mb4_bin` IS NULL THEN 1 ELSE 0 END, " +
+					"`nickname` COLLATE `utf8mb4_bin` ASC, `id` ASC;",
+				params: []any{"bob", "bob", 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Positional Ordering",
+			builder:   NewSelectBuilder(nil, "posts", "id").SeekAfter([]types.ColumnOrdering{types.Asc(1)}, nil),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; No Orderings",
+			builder:   NewSelectBuilder(nil, "posts", "*").SeekAfter(nil, nil),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Mismatched Values",
+			builder:   NewSelectBuilder(nil, "posts", "*").SeekAfter([]types.ColumnOrdering{createdDesc, idDesc}, []any{42}),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; NULL w/o Nulls Placement",
+			builder:   NewSelectBuilder(nil, "posts", "*").SeekAfter([]types.ColumnOrdering{createdDesc, idDesc}, []any{nil, 42}),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Column",
+			builder:   NewSelectBuilder(nil, "posts", "*").SeekAfter([]types.ColumnOrdering{{ColumnName: ".id"}}, []any{42}),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func Test_seekBuilder_Errors(t *testing.T) {
+	idAsc := types.ColumnOrdering{ColumnName: "id", Ordering: types.OrderingAscending}
+
+	tests := []struct {
+		name    string
+		builder builders.Builder
+		wantErr string
+	}{
+		{
+			name:    "No Orderings",
+			builder: NewSelectBuilder(nil, "posts", "*").SeekAfter(nil, []any{42}),
+			wantErr: "encountered 1 error(s)\n\tat least one ordering must be provided to seek with",
+		},
+		{
+			name:    "Mismatched Values",
+			builder: NewSelectBuilder(nil, "posts", "*").Where(condition.Equals("author_id", 7)).SeekAfter([]types.ColumnOrdering{idAsc}, []any{1, 2}),
+			wantErr: "encountered 1 error(s)\n\treceived 2 last values to seek after, but there are 1 orderings",
+		},
+		{
+			name:    "Positional Ordering",
+			builder: NewSelectBuilder(nil, "posts", "id").SeekAfter([]types.ColumnOrdering{idAsc, types.Desc(1)}, nil),
+			wantErr: "encountered 1 error(s)\n\tordering 1 refers to a column position, which can't be used to seek with",
+		},
+		{
+			name: "NULL w/o Nulls Placement on Expression",
+			builder: NewSelectBuilder(nil, "users AS u", "*").Join(join.TypeInner, "teams AS t", join.Using("team_id")).SeekAfter(
+				[]types.ColumnOrdering{types.Asc(expr.Lower("u.nickname")), idAsc}, []any{nil, 42},
+			),
+			wantErr: "encountered 1 error(s)\n\tthe placement of NULL values must be set on the ordering of LOWER(\"u\".\"nickname\") in order to seek after a NULL value",
+		},
+		{
+			name:    "NULL w/o Nulls Placement on Column",
+			builder: NewSelectBuilder(dialect.MySQL, "users", "*").SeekAfter([]types.ColumnOrdering{types.Desc("nickname")}, []any{(*string)(nil)}),
+			wantErr: "encountered 1 error(s)\n\tthe placement of NULL values must be set on the ordering of `nickname` in order to seek after a NULL value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			assert.EqualError(t, err, tt.wantErr)
+			assert.Empty(t, gotQuery)
+			assert.Nil(t, gotParams)
+		})
+	}
+}
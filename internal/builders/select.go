@@ -1,10 +1,16 @@
 package inbuilders
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
 	injoin "github.com/williabk198/jagsqlb/internal/join"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 	inutilities "github.com/williabk198/jagsqlb/internal/utilities"
@@ -12,56 +18,166 @@ import (
 )
 
 type selectBuilder struct {
-	tables  []intypes.Table
-	columns []intypes.SelectColumn
-	errs    intypes.ErrorSlice
+	tables     []intypes.Table
+	columns    []intypes.SelectColumn
+	distinct   bool
+	distinctOn []intypes.Expression
+	with       withClause
+	dialect    dialect.Dialect
+	errs       intypes.ErrorSlice
 }
 
 func (s selectBuilder) Build() (query string, params []any, err error) {
+	return finalizeBuild(s.dialect, s)
+}
+
+func (s selectBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, s)
+}
+
+func (s selectBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, s)
+}
+
+func (s selectBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, s)
+}
+
+func (s selectBuilder) BuildRaw() (query string, params []any, err error) {
+	query, params, err = s.buildStatement()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return s.with.prefix(dialectOrDefault(s.dialect), query, params)
+}
+
+// Dialect implements intypes.RawBuilder.
+func (s selectBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(s.dialect)
+}
+
+// buildStatement builds the SELECT statement without any of its preceding common table expressions
+func (s selectBuilder) buildStatement() (query string, params []any, err error) {
 	if len(s.errs) > 0 {
 		return "", nil, s.errs
 	}
 
+	d := dialectOrDefault(s.dialect)
+
+	distinctStr, params, err := s.renderDistinct(d)
+	if err != nil {
+		return "", nil, err
+	}
+
 	var columnStr string
+	var columnParams []any
 	if len(s.tables) == 1 && len(s.columns) > 0 {
 		// If there is only one table defined, we don't need the table prefixes that you'd get by using
 		// `inutilities.CoalesceSelectColumnsFullString`. So, just get the column names
-		columnStr = inutilities.CoalesceSelectColumnNamesString(s.columns)
+		columnStr, columnParams, err = inutilities.CoalesceSelectColumnNamesString(d, s.columns)
 
 	} else if len(s.columns) > 0 {
-		columnStr = inutilities.CoalesceSelectColumnsFullString(s.columns)
+		columnStr, columnParams, err = inutilities.CoalesceSelectColumnsFullString(d, s.columns)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to render selected columns: %w", err)
 	}
+	params = append(params, columnParams...)
+
+	tableStr, tableParams, err := inutilities.CoalesceTablesString(d, s.tables)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to render tables: %w", err)
+	}
+	params = append(params, tableParams...)
 
 	sb := new(strings.Builder)
 	sb.WriteString("SELECT ")
+	sb.WriteString(distinctStr)
 	sb.WriteString(columnStr)
 	sb.WriteString("FROM ")
-	sb.WriteString(inutilities.CoalesceTablesString(s.tables))
+	sb.WriteString(tableStr)
 	sb.WriteRune(';')
 
-	return sb.String(), nil, nil
+	return sb.String(), params, nil
+}
+
+// renderDistinct renders the "DISTINCT" or "DISTINCT ON" clause of the statement, followed by a space, along with its parameters.
+// If duplicate rows are not being removed, then an empty string is returned.
+func (s selectBuilder) renderDistinct(d dialect.Dialect) (string, []any, error) {
+	if s.distinct {
+		return "DISTINCT ", nil, nil
+	}
+	if len(s.distinctOn) == 0 {
+		return "", nil, nil
+	}
+	if !d.Supports(dialect.ClauseDistinctOn) {
+		return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseDistinctOn)
+	}
+
+	exprStrs := make([]string, len(s.distinctOn))
+	var params []any
+	for i, expr := range s.distinctOn {
+		exprStr, exprParams, err := expr.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to render DISTINCT ON expression: %w", err)
+		}
+		exprStrs[i] = exprStr
+		params = append(params, exprParams...)
+	}
+
+	return fmt.Sprintf("DISTINCT ON (%s) ", strings.Join(exprStrs, ", ")), params, nil
 }
 
-func (s selectBuilder) Table(table string, columns ...string) builders.SelectBuilder {
-	parsedTable, err := tableParser.Parse(table)
+// Distinct implements builders.SelectBuilder.
+func (s selectBuilder) Distinct() builders.SelectBuilder {
+	s.distinct = true
+	s.distinctOn = nil
+	return s
+}
+
+// DistinctOn implements builders.SelectBuilder.
+func (s selectBuilder) DistinctOn(column any, moreColumns ...any) builders.SelectBuilder {
+	s.distinct = false
+	s.distinctOn = nil
+	s.errs = slices.Clone(s.errs)
+
+	for _, col := range append([]any{column}, moreColumns...) {
+		expr, err := parseColumnExpression("DISTINCT ON", col)
+		if err != nil {
+			s.errs = append(s.errs, err)
+			continue
+		}
+		s.distinctOn = append(s.distinctOn, expr)
+	}
+
+	return s
+}
+
+func (s selectBuilder) Table(table any, columns ...any) builders.SelectBuilder {
+	// Copy the slices so that appending to them doesn't affect any other builder sharing the same backing arrays
+	s.tables = slices.Clone(s.tables)
+	s.columns = slices.Clone(s.columns)
+	s.errs = slices.Clone(s.errs)
+
+	parsedTable, err := parseTableSource(table)
 	if err != nil {
 		s.errs = append(s.errs, err)
 	}
 	s.tables = append(s.tables, parsedTable)
 
 	for _, col := range columns {
-		parsedColumn, err := selectColumnParser.Parse(col)
+		parsedColumn, err := parseSelectColumn(col, &parsedTable)
 		if err != nil {
 			s.errs = append(s.errs, err)
 		}
-		parsedColumn.Table = &parsedTable
 		s.columns = append(s.columns, parsedColumn)
 	}
 
 	return s
 }
 
-func (s selectBuilder) Join(joinType injoin.Type, table string, joinRelation injoin.Relation, includeColumns ...string) builders.JoinBuilder {
+func (s selectBuilder) Join(joinType injoin.Type, table any, joinRelation injoin.Relation, includeColumns ...any) builders.JoinBuilder {
 	jb := joinBuilder{
 		selectBuilder: s,
 	}
@@ -74,6 +190,7 @@ func (s selectBuilder) Where(cond incondition.Condition, additionalConds ...inco
 		conditions: []whereCondition{
 			{condition: cond},
 		},
+		dialect: s.dialect,
 	}
 
 	if len(additionalConds) > 0 {
@@ -83,19 +200,87 @@ func (s selectBuilder) Where(cond incondition.Condition, additionalConds ...inco
 	return wb
 }
 
+// GroupBy implements builders.SelectBuilder.
+func (s selectBuilder) GroupBy(column any, moreColumns ...any) builders.GroupByBuilder {
+	return newGroupByBuilder(s.dialect, s, column, moreColumns...)
+}
+
+// SeekAfter implements builders.SelectBuilder.
+func (s selectBuilder) SeekAfter(orderings []types.ColumnOrdering, lastValues []any) builders.LimitBuilder {
+	return newSeekBuilder(selectWhereBuilder{mainQuery: s, dialect: s.dialect}, orderings, lastValues)
+}
+
+// CountQuery implements builders.SelectBuilder.
+func (s selectBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(s.dialect, s)
+}
+
+func (s selectBuilder) countRows() (builders.ExecutableBuilder, bool) {
+	// Replacing the selected columns would change which rows are duplicates
+	if s.distinct || len(s.distinctOn) > 0 {
+		return nil, false
+	}
+
+	s.columns = []intypes.SelectColumn{countColumn}
+	return s, true
+}
+
+// Window implements builders.SelectBuilder.
+func (s selectBuilder) Window(name string, definition inexpr.Window) builders.WindowBuilder {
+	return newWindowBuilder(s.dialect, s).Window(name, definition)
+}
+
+// Union implements builders.SelectBuilder.
+func (s selectBuilder) Union(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(s.dialect, s, compoundUnion, query)
+}
+
+// UnionAll implements builders.SelectBuilder.
+func (s selectBuilder) UnionAll(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(s.dialect, s, compoundUnionAll, query)
+}
+
+// Intersect implements builders.SelectBuilder.
+func (s selectBuilder) Intersect(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(s.dialect, s, compoundIntersect, query)
+}
+
+// Except implements builders.SelectBuilder.
+func (s selectBuilder) Except(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(s.dialect, s, compoundExcept, query)
+}
+
 // Limit implements builders.SelectBuilder.
-func (s selectBuilder) Limit(limit uint) builders.Builder {
+func (s selectBuilder) Limit(limit uint) builders.LockableBuilder {
 	return limitBuilder{
 		precedingBuilder: s,
 		limit:            limit,
+		dialect:          s.dialect,
 	}
 }
 
+func (s selectBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(s.dialect, s).ForUpdate(tables...)
+}
+
+func (s selectBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(s.dialect, s).ForNoKeyUpdate(tables...)
+}
+
+func (s selectBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(s.dialect, s).ForShare(tables...)
+}
+
+func (s selectBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(s.dialect, s).ForKeyShare(tables...)
+}
+
 // Offset implements builders.SelectBuilder.
 func (s selectBuilder) Offset(offset uint) builders.LimitBuilder {
 	return offsetBuilder{
 		precedingBuilder: s,
 		offset:           offset,
+		dialect:          s.dialect,
 	}
 }
 
@@ -104,10 +289,69 @@ func (s selectBuilder) OrderBy(columnOrder types.ColumnOrdering, moreColumnOrder
 	return orderByBuilder{
 		precedingBuilder: s,
 		columnOrderings:  append([]types.ColumnOrdering{columnOrder}, moreColumnOrders...),
+		dialect:          s.dialect,
 	}
 }
 
-func NewSelectBuilder(table string, columns ...string) builders.SelectBuilder {
-	sbuilder := selectBuilder{}
+// NewSelectBuilder creates a SelectBuilder that renders its query using the provided dialect.
+// If `d` is nil, then PostgreSQL will be used.
+func NewSelectBuilder(d dialect.Dialect, table any, columns ...any) builders.SelectBuilder {
+	sbuilder := selectBuilder{dialect: d}
 	return sbuilder.Table(table, columns...)
 }
+
+// parseTableSource converts a table provided to a select query into a Table. Strings are parsed as table definitions,
+// while tables, such as those created with `jagsqlb.SubQuery`, are used as is.
+func parseTableSource(table any) (intypes.Table, error) {
+	switch t := table.(type) {
+	case string:
+		return tableParser.Parse(t)
+	case intypes.Table:
+		if t.SubQuery == nil && t.Name == "" {
+			return t, intypes.ErrMissingTableName
+		}
+		if t.SubQuery != nil && t.Alias == "" {
+			return t, fmt.Errorf("a subquery used as a table must have an alias: %w", intypes.ErrMissingAliasName)
+		}
+		return t, nil
+	default:
+		return intypes.Table{}, fmt.Errorf("invalid table type %T; expected a string or a subquery", table)
+	}
+}
+
+// parseColumnExpression converts a column provided to `clause` into an expression. Strings are parsed as column references,
+// while expressions are used as is.
+func parseColumnExpression(clause string, column any) (intypes.Expression, error) {
+	switch col := column.(type) {
+	case string:
+		if _, err := columnParser.Parse(col); err != nil {
+			return nil, fmt.Errorf("failed to parse %s column %q: %w", clause, col, err)
+		}
+		return inexpr.Column{Name: col}, nil
+	case intypes.Ordering:
+		return nil, fmt.Errorf("invalid %s column: %w", clause, intypes.ErrMisplacedOrdering)
+	case intypes.Expression:
+		return col, nil
+	default:
+		return nil, fmt.Errorf("invalid %s column type %T; expected a string or an expression", clause, column)
+	}
+}
+
+// parseSelectColumn converts a column provided to a select query into a SelectColumn. Strings are parsed as column
+// definitions belonging to `table`, while expressions, aliased or otherwise, are used as is.
+func parseSelectColumn(column any, table *intypes.Table) (intypes.SelectColumn, error) {
+	switch col := column.(type) {
+	case string:
+		parsedColumn, err := selectColumnParser.Parse(col)
+		parsedColumn.Table = table
+		return parsedColumn, err
+	case intypes.SelectColumn:
+		return col, nil
+	case intypes.Ordering:
+		return intypes.SelectColumn{}, fmt.Errorf("invalid select column: %w", intypes.ErrMisplacedOrdering)
+	case intypes.Expression:
+		return intypes.SelectColumn{Expression: col}, nil
+	default:
+		return intypes.SelectColumn{}, fmt.Errorf("invalid select column type %T; expected a string or an expression", column)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/williabk198/jagsqlb/builders"
 	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/expr"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
 	injoin "github.com/williabk198/jagsqlb/internal/join"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
@@ -138,21 +140,51 @@ func Test_selectBuilder_Build(t *testing.T) {
 			wantQuery: `SELECT "testTable".*, "o"."testCol1" FROM "testTable", "public"."other" AS "o";`,
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success, 1 Table w/ Expressions",
+			s: NewSelectBuilder(
+				nil,
+				"orders",
+				"customer_id",
+				expr.Sum("price").As("total"),
+				expr.Coalesce("discount", 0),
+			),
+			wantQuery:  `SELECT "customer_id", SUM("price") AS "total", COALESCE("discount", $1) FROM "orders";`,
+			wantParams: []any{0},
+			assertion:  assert.NoError,
+		},
+		{
+			name: "Success, Multiple Tables w/ Expressions",
+			s: NewSelectBuilder(dialect.MySQL, "orders AS o", expr.Count("*").As("total")).
+				Table("customers AS c", expr.Lower("c.name")),
+			wantQuery: "SELECT COUNT(*) AS `total`, LOWER(`c`.`name`) FROM `orders` AS `o`, `customers` AS `c`;",
+			assertion: assert.NoError,
+		},
 		// NOTE: Not going to try to test for every possible error here. That feels like it would be re-testing the parsers.
 		//       Instead, just test to see if an error for parsing table, column data, and then both.
 		{
 			name:      "Error, Bad Table Value",
-			s:         NewSelectBuilder(".badValue"),
+			s:         NewSelectBuilder(nil, ".badValue"),
 			assertion: assert.Error,
 		},
 		{
 			name:      "Error, Bad Column Value",
-			s:         NewSelectBuilder("testTable", "col1 AS"),
+			s:         NewSelectBuilder(nil, "testTable", "col1 AS"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error, Bad Expression Argument",
+			s:         NewSelectBuilder(nil, "testTable", expr.Sum(".col1")),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error, Invalid Column Type",
+			s:         NewSelectBuilder(nil, "testTable", 42),
 			assertion: assert.Error,
 		},
 		{
 			name:      "Error, Bad Table and Column Value",
-			s:         NewSelectBuilder(".testTable", "col1 AS"),
+			s:         NewSelectBuilder(nil, ".testTable", "col1 AS"),
 			assertion: assert.Error,
 		},
 	}
@@ -169,7 +201,7 @@ func Test_selectBuilder_Build(t *testing.T) {
 func Test_selectBuilder_Table(t *testing.T) {
 	type args struct {
 		table   string
-		columns []string
+		columns []any
 	}
 
 	initialTable := intypes.Table{Alias: "it", Name: "initTable"}
@@ -204,7 +236,7 @@ func Test_selectBuilder_Table(t *testing.T) {
 			s:    selectBuilder{tables: initialTables, columns: initialColumns},
 			args: args{
 				table:   "testTable",
-				columns: []string{"testColumn"},
+				columns: []any{"testColumn"},
 			},
 			want: selectBuilder{
 				tables: []intypes.Table{initialTable, testTable},
@@ -221,7 +253,7 @@ func Test_selectBuilder_Table(t *testing.T) {
 			s:    selectBuilder{tables: initialTables, columns: initialColumns},
 			args: args{
 				table:   "testTable AS tt",
-				columns: []string{"testColumn AS tc"},
+				columns: []any{"testColumn AS tc"},
 			},
 			want: selectBuilder{
 				tables: []intypes.Table{initialTable, testTableWithAlias},
@@ -239,7 +271,7 @@ func Test_selectBuilder_Table(t *testing.T) {
 			s:    selectBuilder{tables: initialTables, columns: initialColumns},
 			args: args{
 				table:   "testing.testTable AS tt",
-				columns: []string{"testColumn AS tc"},
+				columns: []any{"testColumn AS tc"},
 			},
 			want: selectBuilder{
 				tables: []intypes.Table{initialTable, testTableWithAliasAndSchema},
@@ -257,7 +289,7 @@ func Test_selectBuilder_Table(t *testing.T) {
 			s:    selectBuilder{tables: initialTables, columns: initialColumns},
 			args: args{
 				table:   "testing.testTable",
-				columns: []string{"testColumn"},
+				columns: []any{"testColumn"},
 			},
 			want: selectBuilder{
 				tables: []intypes.Table{initialTable, testTableWithSchema},
@@ -282,7 +314,7 @@ func Test_selectBuilder_Join(t *testing.T) {
 		joinType       injoin.Type
 		table          string
 		joinRelation   injoin.Relation
-		includeColumns []string
+		includeColumns []any
 	}
 
 	testTable1 := intypes.Table{Alias: "t1", Name: "table1"}
@@ -308,7 +340,7 @@ func Test_selectBuilder_Join(t *testing.T) {
 				joinType:       join.TypeInner,
 				table:          "table2 AS t2",
 				joinRelation:   join.On(condition.Equals("t1.col1", condition.ColumnValue("t2.col2"))),
-				includeColumns: []string{"col3"},
+				includeColumns: []any{"col3"},
 			},
 			want: joinBuilder{
 				selectBuilder: selectBuilder{
@@ -400,7 +432,7 @@ func Test_selectBuilder_Where(t *testing.T) {
 func TestNewSelectBuilder(t *testing.T) {
 	type args struct {
 		table   string
-		columns []string
+		columns []any
 	}
 
 	testTable := intypes.Table{Name: "testTable"}
@@ -426,7 +458,7 @@ func TestNewSelectBuilder(t *testing.T) {
 			name: "Success w/ Column; w/o Schema & Aliases",
 			args: args{
 				table:   "testTable",
-				columns: []string{"testColumn"},
+				columns: []any{"testColumn"},
 			},
 			want: selectBuilder{
 				tables: []intypes.Table{testTable},
@@ -441,7 +473,7 @@ func TestNewSelectBuilder(t *testing.T) {
 			name: "Success w/ Column & Aliases; w/o Schema",
 			args: args{
 				table:   "testTable AS tt",
-				columns: []string{"testColumn AS tc"},
+				columns: []any{"testColumn AS tc"},
 			},
 			want: selectBuilder{
 				tables: []intypes.Table{testTableWithAlias},
@@ -457,7 +489,7 @@ func TestNewSelectBuilder(t *testing.T) {
 			name: "Success w/ Column, Aliases & Schema",
 			args: args{
 				table:   "testing.testTable AS tt",
-				columns: []string{"testColumn AS tc"},
+				columns: []any{"testColumn AS tc"},
 			},
 			want: selectBuilder{
 				tables: []intypes.Table{testTableWithAliasAndSchema},
@@ -473,7 +505,7 @@ func TestNewSelectBuilder(t *testing.T) {
 			name: "Success w/ Column & Schema; w/o Aliases",
 			args: args{
 				table:   "testing.testTable",
-				columns: []string{"testColumn"},
+				columns: []any{"testColumn"},
 			},
 			want: selectBuilder{
 				tables: []intypes.Table{testTableWithSchema},
@@ -487,7 +519,7 @@ func TestNewSelectBuilder(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := NewSelectBuilder(tt.args.table, tt.args.columns...)
+			got := NewSelectBuilder(nil, tt.args.table, tt.args.columns...)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -582,3 +614,56 @@ func Test_selectBuilder_OrderBy(t *testing.T) {
 		})
 	}
 }
+
+func Test_selectBuilder_MisplacedOrdering(t *testing.T) {
+	ordering := types.Desc("created_at")
+
+	tests := []struct {
+		name    string
+		builder builders.Builder
+	}{
+		{
+			name:    "Select Column",
+			builder: NewSelectBuilder(nil, "posts", "id", ordering),
+		},
+		{
+			name:    "Function Argument",
+			builder: NewSelectBuilder(nil, "posts", expr.Max(ordering)),
+		},
+		{
+			name:    "Group By",
+			builder: NewSelectBuilder(nil, "posts", "author_id").GroupBy(ordering),
+		},
+		{
+			name:    "Condition Column",
+			builder: NewSelectBuilder(nil, "posts", "*").Where(condition.Equals(ordering, 1)),
+		},
+		{
+			name:    "Condition Value",
+			builder: NewSelectBuilder(nil, "posts", "*").Where(condition.GreaterThan("updated_at", ordering)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, _, err := tt.builder.Build()
+			assert.ErrorIs(t, err, intypes.ErrMisplacedOrdering)
+			assert.Empty(t, gotQuery)
+		})
+	}
+}
+
+func Test_selectBuilder_Table_Branches(t *testing.T) {
+	// Three failed tables leave room in the error slice for a fourth error without it needing to grow
+	base := NewSelectBuilder(nil, ".a", "*").Table(".b").Table(".c")
+
+	first := base.Table(".first")
+	second := base.Table(".second")
+
+	_, _, err := first.Build()
+	assert.ErrorContains(t, err, `".first"`)
+	assert.NotContains(t, err.Error(), `".second"`)
+
+	_, _, err = second.Build()
+	assert.ErrorContains(t, err, `".second"`)
+	assert.NotContains(t, err.Error(), `".first"`)
+}
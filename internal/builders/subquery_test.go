@@ -0,0 +1,134 @@
+package inbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/join"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+func Test_selectBuilder_SubQueryTables(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	subQuery := func(query builders.Builder, alias string, lateral bool) intypes.Table {
+		return intypes.Table{Alias: alias, SubQuery: inexpr.Subquery{Builder: query}, Lateral: lateral}
+	}
+
+	paidTotals := NewSelectBuilder(nil, "orders", "customer_id", inexpr.Function{Name: "SUM", Args: []any{"total"}}.As("spent")).
+		Where(condition.Equals("status", "paid")).GroupBy("customer_id")
+	latestOrder := NewSelectBuilder(nil, "orders", "total").
+		Where(condition.Equals("customer_id", condition.ColumnValue("c.id")), condition.GreaterThan("total", 10)).
+		OrderBy(types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingDescending}).Limit(1)
+	mysqlLatestOrder := NewSelectBuilder(dialect.MySQL, "orders", "total").
+		Where(condition.Equals("customer_id", condition.ColumnValue("c.id")), condition.GreaterThan("total", 10)).
+		OrderBy(types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingDescending}).Limit(1)
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Success; From Subquery",
+			builder: NewSelectBuilder(nil, subQuery(paidTotals, "t", false), "*").Where(condition.GreaterThan("spent", 100)),
+			wants: wants{
+				query: `SELECT * FROM (SELECT "customer_id", SUM("total") AS "spent" FROM "orders" WHERE "status" = $1 ` +
+					`GROUP BY "customer_id") AS "t" WHERE "spent" > $2;`,
+				params: []any{"paid", 100},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Join Subquery",
+			builder: NewSelectBuilder(nil, "customers AS c", "name", inexpr.Function{Name: "COALESCE", Args: []any{"c.credit", 0}}).
+				Join(
+					join.TypeLeft,
+					subQuery(paidTotals, "agg", false),
+					join.On(condition.Equals("agg.customer_id", condition.ColumnValue("c.id")), condition.GreaterThan("agg.spent", 50)),
+					"spent",
+				).
+				Where(condition.Equals("c.active", true)),
+			wants: wants{
+				query: `SELECT "c"."name", COALESCE("c"."credit", $1), "agg"."spent" FROM "customers" AS "c" ` +
+					`LEFT JOIN (SELECT "customer_id", SUM("total") AS "spent" FROM "orders" WHERE "status" = $2 GROUP BY "customer_id") AS "agg" ` +
+					`ON "agg"."customer_id" = "c"."id" AND "agg"."spent" > $3 WHERE "c"."active" = $4;`,
+				params: []any{0, "paid", 50, true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; Lateral",
+			builder: NewSelectBuilder(nil, "customers AS c", "name").Table(subQuery(latestOrder, "l", true), "total"),
+			wants: wants{
+				query: `SELECT "c"."name", "l"."total" FROM "customers" AS "c", LATERAL (SELECT "total" FROM "orders" ` +
+					`WHERE "customer_id" = "c"."id" AND "total" > $1 ORDER BY "created_at" DESC LIMIT 1) AS "l";`,
+				params: []any{10},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Join Lateral",
+			builder: NewSelectBuilder(dialect.MySQL, "customers AS c", "name").Join(
+				join.TypeInner, subQuery(mysqlLatestOrder, "l", true), join.On(condition.GreaterThan("l.total", 20)), "total",
+			),
+			wants: wants{
+				query: "SELECT `c`.`name`, `l`.`total` FROM `customers` AS `c` INNER JOIN LATERAL (SELECT `total` FROM `orders` " +
+					"WHERE `customer_id` = `c`.`id` AND `total` > ? ORDER BY `created_at` DESC LIMIT 1) AS `l` ON `l`.`total` > ?;",
+				params: []any{10, 20},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; SQL Server Subquery",
+			builder: NewSelectBuilder(dialect.SQLServer, subQuery(NewSelectBuilder(dialect.SQLServer, "orders", "id").Where(condition.Equals("status", "paid")), "p", false), "id"),
+			wants: wants{
+				query:  `SELECT [id] FROM (SELECT [id] FROM [orders] WHERE [status] = @p1) AS [p];`,
+				params: []any{"paid"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Lateral Unsupported",
+			builder:   NewSelectBuilder(dialect.SQLite, "customers AS c", "name").Table(subQuery(latestOrder, "l", true), "total"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Missing Alias",
+			builder:   NewSelectBuilder(nil, subQuery(paidTotals, "", false), "*"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Missing Alias in Join",
+			builder:   NewSelectBuilder(nil, "customers AS c", "*").Join(join.TypeInner, subQuery(paidTotals, "", false), join.Using("customer_id")),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Invalid Table Type",
+			builder:   NewSelectBuilder(nil, 42, "*"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Invalid Subquery",
+			builder:   NewSelectBuilder(nil, subQuery(NewSelectBuilder(nil, ".orders", "*"), "o", false), "*"),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
@@ -1,11 +1,17 @@
 package inbuilders
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	injoin "github.com/williabk198/jagsqlb/internal/join"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 	"github.com/williabk198/jagsqlb/internal/utilities/parsers"
 )
@@ -15,77 +21,134 @@ type updateBuilder struct {
 	columns    []intypes.Column
 	vals       []any
 	fromTables []intypes.Table
+	joins      []joinCondition
+	with       withClause
+	dialect    dialect.Dialect
 	errs       intypes.ErrorSlice
 }
 
 // Build implements builders.UpdateBuilder.
 func (u updateBuilder) Build() (query string, queryParams []any, err error) {
+	return finalizeBuild(u.dialect, u)
+}
+
+func (u updateBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, u)
+}
+
+func (u updateBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, u)
+}
+
+func (u updateBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, u)
+}
+
+// BuildRaw implements intypes.RawBuilder.
+func (u updateBuilder) BuildRaw() (query string, queryParams []any, err error) {
+	query, queryParams, err = u.buildStatement()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return u.with.prefix(dialectOrDefault(u.dialect), query, queryParams)
+}
+
+// Dialect implements intypes.RawBuilder.
+func (u updateBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(u.dialect)
+}
+
+// buildStatement builds the UPDATE statement without any of its preceding common table expressions
+func (u updateBuilder) buildStatement() (query string, queryParams []any, err error) {
 	if len(u.errs) > 0 {
 		return "", nil, fmt.Errorf("failed to build base update query: %w", u.errs)
 	}
 
+	d := dialectOrDefault(u.dialect)
 	sb := new(strings.Builder)
 	sb.WriteString("UPDATE ")
-	sb.WriteString(u.table.String())
+	sb.WriteString(u.table.Render(d))
 
-	sb.WriteString(" SET ")
-	sb.WriteString(u.columns[0].String())
-	sb.WriteRune('=')
-	if cv, ok := u.vals[0].(incondition.ColumnValue); ok {
-		sb.WriteString(cv.ColumnName)
-	} else {
-		sb.WriteRune('?')
+	if len(u.joins) > 0 {
+		if !d.Supports(dialect.ClauseUpdateJoin) {
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseUpdateJoin)
+		}
+
+		joinStr, joinParams, err := renderJoins(d, u.joins)
+		if err != nil {
+			return "", nil, err
+		}
+		sb.WriteString(joinStr)
+		queryParams = append(queryParams, joinParams...)
 	}
 
-	for i := 1; i < len(u.columns); i++ {
-		sb.WriteString(", ")
-		sb.WriteString(u.columns[i].String())
+	if len(u.columns) == 0 {
+		return "", nil, fmt.Errorf("no columns were provided to set")
+	}
+
+	sb.WriteString(" SET ")
+	for i, column := range u.columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(column.Render(d))
 		sb.WriteRune('=')
-		if cv, ok := u.vals[i].(incondition.ColumnValue); ok {
-			sb.WriteString(cv.ColumnName)
-		} else {
-			sb.WriteRune('?')
+
+		value, valueParams, err := assignmentValue(u.vals[i]).Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to render value of %s: %w", column.Render(d), err)
 		}
+		sb.WriteString(value)
+		queryParams = append(queryParams, valueParams...)
 	}
 
 	if len(u.fromTables) > 0 {
+		if !d.Supports(dialect.ClauseUpdateFrom) {
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseUpdateFrom)
+		}
+
 		sb.WriteString(" FROM ")
-		sb.WriteString(u.fromTables[0].String())
+		sb.WriteString(u.fromTables[0].Render(d))
 		for i := 1; i < len(u.fromTables); i++ {
 			sb.WriteString(", ")
-			sb.WriteString(u.fromTables[i].String())
+			sb.WriteString(u.fromTables[i].Render(d))
 		}
 	}
 
 	sb.WriteRune(';')
 
-	// Go through the values and remove any value that is of the type `incondition.ColumnValue`.
-	// Doing this here instead of inside the previous maybe slightly more inefficient, but easier to understand.
-	// Performance issues will be handled later, if the need arises.
-	for i := range u.vals {
-		if _, ok := u.vals[i].(incondition.ColumnValue); ok {
-			u.vals = append(u.vals[:i], u.vals[i+1:])
-		}
+	return sb.String(), queryParams, nil
+}
+
+// Set implements builders.UpdateBuilder.
+func (u updateBuilder) Set(column string, value any) builders.UpdateSetBuilder {
+	colData, err := columnParser.Parse(column)
+	if err != nil {
+		u.errs = append(slices.Clone(u.errs), err)
+		return u
 	}
 
-	return finalizeQuery(sb.String(), 0), u.vals, nil
+	// Copy the columns and values so that appending to them doesn't affect any other builder sharing the same backing arrays
+	u.columns = append(slices.Clone(u.columns), colData)
+	u.vals = append(slices.Clone(u.vals), value)
+	return u
 }
 
 // SetMap implements builders.UpdateBuilder.
 func (u updateBuilder) SetMap(colValMap map[string]any) builders.UpdateFromWhereBuilder {
-	u.columns = make([]intypes.Column, len(colValMap))
-	u.vals = make([]any, len(colValMap))
+	u.columns = make([]intypes.Column, 0, len(colValMap))
+	u.vals = make([]any, 0, len(colValMap))
 
-	i := 0
-	for k, v := range colValMap {
+	// Go through the columns in sorted order so that the query, and the order of its parameters, is the same on every build
+	for _, k := range slices.Sorted(maps.Keys(colValMap)) {
 		colData, err := columnParser.Parse(k)
 		if err != nil {
-			u.errs = append(u.errs, err)
+			u.errs = append(slices.Clone(u.errs), err)
 			return u
 		}
-		u.columns[i] = colData
-		u.vals[i] = v
-		i++
+		u.columns = append(u.columns, colData)
+		u.vals = append(u.vals, colValMap[k])
 	}
 
 	return u
@@ -95,7 +158,7 @@ func (u updateBuilder) SetMap(colValMap map[string]any) builders.UpdateFromWhere
 func (u updateBuilder) SetStruct(value any) builders.UpdateFromWhereBuilder {
 	cols, vals, err := parsers.ParseColumnTag(intypes.QueryTypeUpdate, value)
 	if err != nil {
-		u.errs = append(u.errs, fmt.Errorf("failed to process argument of SetStruct: %w", err))
+		u.errs = append(slices.Clone(u.errs), fmt.Errorf("failed to process argument of SetStruct: %w", err))
 		return u
 	}
 
@@ -103,7 +166,7 @@ func (u updateBuilder) SetStruct(value any) builders.UpdateFromWhereBuilder {
 	for i, c := range cols {
 		colData, err := columnParser.Parse(c)
 		if err != nil {
-			u.errs = append(u.errs, err)
+			u.errs = append(slices.Clone(u.errs), err)
 			return u
 		}
 		u.columns[i] = colData
@@ -113,13 +176,30 @@ func (u updateBuilder) SetStruct(value any) builders.UpdateFromWhereBuilder {
 	return u
 }
 
+// Join implements builders.UpdateBuilder.
+func (u updateBuilder) Join(joinType injoin.Type, table string, joinRelation injoin.Relation) builders.UpdateBuilder {
+	joinCond, err := newJoinCondition(joinType, table, joinRelation)
+	if err != nil {
+		u.errs = append(slices.Clone(u.errs), err)
+		return u
+	}
+
+	u.joins = append(slices.Clone(u.joins), joinCond)
+	return u
+}
+
 // From implements builders.UpdateBuilder.
 func (u updateBuilder) From(table string, moreTables ...string) builders.ReturningWhereBuilder {
+	// Copy the slices so that appending to them doesn't affect any other builder sharing the same backing arrays
+	u.fromTables = slices.Clone(u.fromTables)
+	u.errs = slices.Clone(u.errs)
+
 	tableData, err := tableParser.Parse(table)
 	if err != nil {
 		u.errs = append(u.errs, err)
 		return returningWhereBuilder{
 			mainQuery: u,
+			dialect:   u.dialect,
 		}
 	}
 	u.fromTables = append(u.fromTables, tableData)
@@ -130,6 +210,7 @@ func (u updateBuilder) From(table string, moreTables ...string) builders.Returni
 			u.errs = append(u.errs, err)
 			return returningWhereBuilder{
 				mainQuery: u,
+				dialect:   u.dialect,
 			}
 		}
 		u.fromTables = append(u.fromTables, tableData)
@@ -137,14 +218,15 @@ func (u updateBuilder) From(table string, moreTables ...string) builders.Returni
 
 	return returningWhereBuilder{
 		mainQuery: u,
+		dialect:   u.dialect,
 	}
 }
 
 func (u updateBuilder) Where(cond incondition.Condition, moreConds ...incondition.Condition) builders.ReturningWhereBuilder {
 	var rwb builders.ReturningWhereBuilder = returningWhereBuilder{
-		mainQuery:      u,
-		conditions:     whereConditions{{condition: cond}},
-		existingParams: len(u.vals),
+		mainQuery:  u,
+		conditions: whereConditions{{condition: cond}},
+		dialect:    u.dialect,
 	}
 
 	if len(moreConds) > 0 {
@@ -154,8 +236,10 @@ func (u updateBuilder) Where(cond incondition.Condition, moreConds ...inconditio
 	return rwb
 }
 
-func NewUpdateBuilder(table string) builders.UpdateBuilder {
-	ub := updateBuilder{}
+// NewUpdateBuilder creates an UpdateBuilder that renders its query using the provided dialect.
+// If `d` is nil, then PostgreSQL will be used.
+func NewUpdateBuilder(d dialect.Dialect, table string) builders.UpdateBuilder {
+	ub := updateBuilder{dialect: d}
 
 	tableData, err := tableParser.Parse(table)
 	if err != nil {
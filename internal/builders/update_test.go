@@ -7,8 +7,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/williabk198/jagsqlb/builders"
 	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/expr"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/join"
 )
 
 func Test_updateBuilder_Build(t *testing.T) {
@@ -61,6 +64,35 @@ func Test_updateBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; SQL Server",
+			u: updateBuilder{
+				table: intypes.Table{Name: "table1"},
+				columns: []intypes.Column{
+					{Name: "col1"},
+				},
+				vals:    []any{"testing"},
+				dialect: dialect.SQLServer,
+			},
+			wants: wants{
+				query:  `UPDATE [table1] SET [col1]=@p1;`,
+				params: []any{"testing"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; From Unsupported by Dialect",
+			u: updateBuilder{
+				table: intypes.Table{Name: "table1"},
+				columns: []intypes.Column{
+					{Name: "col1"},
+				},
+				vals:       []any{"testing"},
+				fromTables: []intypes.Table{{Name: "table2"}},
+				dialect:    dialect.MySQL,
+			},
+			assertion: assert.Error,
+		},
 		{
 			name: "Error; ErrorSlice not Empty",
 			u: updateBuilder{
@@ -100,12 +132,161 @@ func Test_updateBuilder_SetMap(t *testing.T) {
 				vals:    []any{"something"},
 			},
 		},
+		{
+			name: "Success; Sorted Columns",
+			u:    updateBuilder{},
+			args: args{
+				colValMap: map[string]any{"col3": 3, "col1": 1, "t1.col2": 2, "col0": 0},
+			},
+			want: updateBuilder{
+				columns: []intypes.Column{{Name: "col0"}, {Name: "col1"}, {Name: "col3"}, {Name: "col2", Table: &intypes.Table{Name: "t1"}}},
+				vals:    []any{0, 1, 3, 2},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert.Equal(t, tt.want, tt.u.SetMap(tt.args.colValMap))
 		})
 	}
+
+	_, _, err := updateBuilder{}.SetMap(map[string]any{"col1": 1, ".col2": 2}).Build()
+	assert.Error(t, err)
+}
+
+func Test_updateBuilder_Set(t *testing.T) {
+	base := NewUpdateBuilder(nil, "products").Set("name", "widget")
+
+	// Setting more columns on the same builder multiple times should not cause the results to affect each other
+	first := base.Set("stock", expr.Col("stock").Minus(1))
+	second := base.Set("updated_at", expr.Now())
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     []any
+		query     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "Success; Expression",
+			builder:   first.Where(condition.Equals("id", 7)),
+			query:     `UPDATE "products" SET "name"=$1, "stock"="stock" - $2 WHERE "id" = $3;`,
+			wants:     []any{"widget", 1, 7},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Success; Keyword",
+			builder:   second,
+			query:     `UPDATE "products" SET "name"=$1, "updated_at"=CURRENT_TIMESTAMP;`,
+			wants:     []any{"widget"},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Insertion Order",
+			builder: NewUpdateBuilder(dialect.MySQL, "products").
+				Set("z", expr.Default()).
+				Set("a", incondition.ColumnValue{ColumnName: "p.b"}).
+				Set("m", expr.Col("price").Times(1.1).Plus(expr.Col("fee"))),
+			query:     "UPDATE `products` SET `z`=DEFAULT, `a`=`p`.`b`, `m`=(`price` * ?) + `fee`;",
+			wants:     []any{1.1},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Bad Column",
+			builder:   base.Set(".stock", 1),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Value",
+			builder:   base.Set("stock", expr.Col(".stock")),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; No Columns",
+			builder:   NewUpdateBuilder(nil, "products"),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.query, gotQuery)
+			assert.Equal(t, tt.wants, gotParams)
+		})
+	}
+}
+
+func Test_updateBuilder_Join(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	onCustomer := join.On(
+		condition.Equals("o.customer_id", condition.ColumnValue("c.id")),
+		condition.GreaterThan("c.tier", 2),
+	)
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			builder: NewUpdateBuilder(dialect.MySQL, "orders o").Join(join.TypeInner, "customers c", onCustomer).
+				Set("o.priority", incondition.ColumnValue{ColumnName: "c.tier"}).
+				Set("o.note", "vip").
+				Where(condition.Equals("c.active", true)),
+			wants: wants{
+				query: "UPDATE `orders` AS `o` INNER JOIN `customers` AS `c` ON `o`.`customer_id` = `c`.`id` AND `c`.`tier` > ? " +
+					"SET `o`.`priority`=`c`.`tier`, `o`.`note`=? WHERE `c`.`active` = ?;",
+				params: []any{2, "vip", true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Multiple Joins",
+			builder: NewUpdateBuilder(dialect.MySQL, "orders o").
+				Join(join.TypeInner, "customers c", onCustomer).
+				Join(join.TypeLeft, "regions r", join.Using("region_id")).
+				SetMap(map[string]any{"o.region": incondition.ColumnValue{ColumnName: "r.name"}}),
+			wants: wants{
+				query: "UPDATE `orders` AS `o` INNER JOIN `customers` AS `c` ON `o`.`customer_id` = `c`.`id` AND `c`.`tier` > ? " +
+					"LEFT JOIN `regions` AS `r` USING (`region_id`) SET `o`.`region`=`r`.`name`;",
+				params: []any{2},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Unsupported by Dialect",
+			builder:   NewUpdateBuilder(nil, "orders o").Join(join.TypeInner, "customers c", onCustomer).Set("o.priority", 1),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Join Table",
+			builder:   NewUpdateBuilder(dialect.MySQL, "orders o").Join(join.TypeInner, "customers AS", onCustomer).Set("o.priority", 1),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Bad Join Condition",
+			builder: NewUpdateBuilder(dialect.MySQL, "orders o").
+				Join(join.TypeInner, "customers c", join.On(condition.Equals(".id", 1))).
+				Set("o.priority", 1),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
 }
 
 func Test_updateBuilder_SetStruct(t *testing.T) {
@@ -274,7 +455,6 @@ func Test_updateBuilder_Where(t *testing.T) {
 				conditions: whereConditions{
 					{condition: condition.Equals("col2", 56)},
 				},
-				existingParams: 1,
 			},
 		},
 	}
@@ -317,7 +497,23 @@ func TestNewUpdateBuilder(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, NewUpdateBuilder(tt.args.table))
+			assert.Equal(t, tt.want, NewUpdateBuilder(nil, tt.args.table))
 		})
 	}
 }
+
+func Test_updateBuilder_Set_Branches(t *testing.T) {
+	// Three failed columns leave room in the error slice for a fourth error without it needing to grow
+	base := NewUpdateBuilder(nil, "users").Set(".a", 1).Set(".b", 2).Set(".c", 3)
+
+	first := base.Set(".first", 4)
+	second := base.Set(".second", 5)
+
+	_, _, err := first.Build()
+	assert.ErrorContains(t, err, `".first"`)
+	assert.NotContains(t, err.Error(), `".second"`)
+
+	_, _, err = second.Build()
+	assert.ErrorContains(t, err, `".second"`)
+	assert.NotContains(t, err.Error(), `".first"`)
+}
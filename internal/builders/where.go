@@ -1,48 +1,71 @@
 package inbuilders
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
 	"github.com/williabk198/jagsqlb/types"
 )
 
 // selectWhereBuilder implements `builders.SelectWhereBuilder` and represents the WHERE clause in a SELECT statement
 type selectWhereBuilder struct {
-	mainQuery      builders.Builder
-	conditions     whereConditions
-	existingParams int
+	mainQuery  builders.Builder
+	conditions whereConditions
+	dialect    dialect.Dialect
 }
 
 func (w selectWhereBuilder) Build() (query string, queryParams []any, err error) {
+	return finalizeBuild(w.dialect, w)
+}
+
+func (w selectWhereBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, w)
+}
+
+func (w selectWhereBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, w)
+}
+
+func (w selectWhereBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, w)
+}
+
+func (w selectWhereBuilder) BuildRaw() (query string, queryParams []any, err error) {
+	d := dialectOrDefault(w.dialect)
 	sb := new(strings.Builder)
-	mainQueryStr, params, err := w.mainQuery.Build()
+	mainQueryStr, params, err := buildRaw(w.mainQuery)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to build preceding query: %w", err)
 	}
 
-	sb.WriteString(mainQueryStr[:len(mainQueryStr)-1]) // write the primary query string without the trailing ";"
-	sb.WriteString(" WHERE ")
-
-	for _, cond := range w.conditions {
-		condStr, condParams, err := cond.condition.Parameterize()
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to parameterize condition %q: %w", cond, err)
-		}
+	// If none of the conditions are applicable, then there is no "WHERE" clause
+	if w.conditions.isEmpty() {
+		return mainQueryStr, params, nil
+	}
 
-		params = append(params, condParams...)
-		if cond.conjunction != "" {
-			sb.WriteRune(' ')
-			sb.WriteString(cond.conjunction)
-			sb.WriteRune(' ')
-		}
-		sb.WriteString(condStr)
+	condStr, condParams, err := w.conditions.parameterize(d)
+	if err != nil {
+		return "", nil, err
 	}
+
+	sb.WriteString(mainQueryStr[:len(mainQueryStr)-1]) // write the primary query string without the trailing ";"
+	sb.WriteString(" WHERE ")
+	sb.WriteString(condStr)
 	sb.WriteRune(';')
 
-	return finalizeQuery(sb.String(), w.existingParams), params, nil
+	return sb.String(), append(params, condParams...), nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (w selectWhereBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(w.dialect)
 }
 
 func (w selectWhereBuilder) And(cond incondition.Condition, additionalConds ...incondition.Condition) builders.SelectWhereBuilder {
@@ -78,19 +101,67 @@ func (w selectWhereBuilder) Or(cond incondition.Condition, additionalConds ...in
 	return w
 }
 
+// GroupBy implements builders.SelectWhereBuilder.
+func (w selectWhereBuilder) GroupBy(column any, moreColumns ...any) builders.GroupByBuilder {
+	return newGroupByBuilder(w.dialect, w, column, moreColumns...)
+}
+
+// Window implements builders.SelectWhereBuilder.
+func (w selectWhereBuilder) Window(name string, definition inexpr.Window) builders.WindowBuilder {
+	return newWindowBuilder(w.dialect, w).Window(name, definition)
+}
+
+// Union implements builders.SelectWhereBuilder.
+func (w selectWhereBuilder) Union(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(w.dialect, w, compoundUnion, query)
+}
+
+// UnionAll implements builders.SelectWhereBuilder.
+func (w selectWhereBuilder) UnionAll(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(w.dialect, w, compoundUnionAll, query)
+}
+
+// Intersect implements builders.SelectWhereBuilder.
+func (w selectWhereBuilder) Intersect(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(w.dialect, w, compoundIntersect, query)
+}
+
+// Except implements builders.SelectWhereBuilder.
+func (w selectWhereBuilder) Except(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(w.dialect, w, compoundExcept, query)
+}
+
 // Limit implements builders.WhereBuilder.
-func (w selectWhereBuilder) Limit(limit uint) builders.Builder {
+func (w selectWhereBuilder) Limit(limit uint) builders.LockableBuilder {
 	return limitBuilder{
 		precedingBuilder: w,
 		limit:            limit,
+		dialect:          w.dialect,
 	}
 }
 
+func (w selectWhereBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(w.dialect, w).ForUpdate(tables...)
+}
+
+func (w selectWhereBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(w.dialect, w).ForNoKeyUpdate(tables...)
+}
+
+func (w selectWhereBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(w.dialect, w).ForShare(tables...)
+}
+
+func (w selectWhereBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(w.dialect, w).ForKeyShare(tables...)
+}
+
 // Offset implements builders.WhereBuilder.
 func (w selectWhereBuilder) Offset(offset uint) builders.LimitBuilder {
 	return offsetBuilder{
 		precedingBuilder: w,
 		offset:           offset,
+		dialect:          w.dialect,
 	}
 }
 
@@ -99,44 +170,94 @@ func (w selectWhereBuilder) OrderBy(ordering types.ColumnOrdering, moreOrderings
 	return orderByBuilder{
 		precedingBuilder: w,
 		columnOrderings:  append([]types.ColumnOrdering{ordering}, moreOrderings...),
+		dialect:          w.dialect,
+	}
+}
+
+// SeekAfter implements builders.SelectWhereBuilder.
+func (w selectWhereBuilder) SeekAfter(orderings []types.ColumnOrdering, lastValues []any) builders.LimitBuilder {
+	return newSeekBuilder(w, orderings, lastValues)
+}
+
+// CountQuery implements builders.SelectWhereBuilder.
+func (w selectWhereBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(w.dialect, w)
+}
+
+func (w selectWhereBuilder) countRows() (builders.ExecutableBuilder, bool) {
+	c, ok := w.mainQuery.(countable)
+	if !ok {
+		return nil, false
 	}
+
+	counted, ok := c.countRows()
+	if !ok {
+		return nil, false
+	}
+
+	w.mainQuery = counted
+	return w, true
 }
 
 //TODO: Look into a better way of handling returningWhereBuilder. A lot of duplicated code here
 
 type returningWhereBuilder struct {
-	mainQuery      builders.Builder
-	conditions     whereConditions
-	existingParams int
+	mainQuery  builders.Builder
+	conditions whereConditions
+	dialect    dialect.Dialect
 }
 
 func (rwb returningWhereBuilder) Build() (query string, queryParams []any, err error) {
+	return finalizeBuild(rwb.dialect, rwb)
+}
+
+func (rwb returningWhereBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, rwb)
+}
+
+func (rwb returningWhereBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, rwb)
+}
+
+func (rwb returningWhereBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, rwb)
+}
+
+func (rwb returningWhereBuilder) BuildRaw() (query string, queryParams []any, err error) {
+	d := dialectOrDefault(rwb.dialect)
 	sb := new(strings.Builder)
-	mainQueryStr, params, err := rwb.mainQuery.Build()
+	mainQueryStr, params, err := buildRaw(rwb.mainQuery)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to build preceding query: %w", err)
 	}
 
-	sb.WriteString(mainQueryStr[:len(mainQueryStr)-1]) // write the primary query string without the trailing ";"
-	sb.WriteString(" WHERE ")
+	// A `returningWhereBuilder` is returned by `From` without any conditions, in which case there is no "WHERE" clause
+	if len(rwb.conditions) == 0 {
+		return mainQueryStr, params, nil
+	}
 
-	for _, cond := range rwb.conditions {
-		condStr, condParams, err := cond.condition.Parameterize()
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to parameterize condition %q: %w", cond, err)
-		}
+	// Unlike a select, leaving out the "WHERE" clause would update or delete every row, which is unlikely to be intended
+	// when conditions were given
+	if rwb.conditions.isEmpty() {
+		return "", nil, fmt.Errorf("every condition of the WHERE clause was skipped, which would affect every row")
+	}
 
-		params = append(params, condParams...)
-		if cond.conjunction != "" {
-			sb.WriteRune(' ')
-			sb.WriteString(cond.conjunction)
-			sb.WriteRune(' ')
-		}
-		sb.WriteString(condStr)
+	condStr, condParams, err := rwb.conditions.parameterize(d)
+	if err != nil {
+		return "", nil, err
 	}
+
+	sb.WriteString(mainQueryStr[:len(mainQueryStr)-1]) // write the primary query string without the trailing ";"
+	sb.WriteString(" WHERE ")
+	sb.WriteString(condStr)
 	sb.WriteRune(';')
 
-	return finalizeQuery(sb.String(), rwb.existingParams), params, nil
+	return sb.String(), append(params, condParams...), nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (rwb returningWhereBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(rwb.dialect)
 }
 
 func (rwb returningWhereBuilder) And(cond incondition.Condition, additionalConds ...incondition.Condition) builders.ReturningWhereBuilder {
@@ -171,9 +292,10 @@ func (rwb returningWhereBuilder) Or(cond incondition.Condition, additionalConds
 	return rwb
 }
 
-func (rwb returningWhereBuilder) Returning(column string, moreColumns ...string) builders.Builder {
+func (rwb returningWhereBuilder) Returning(column string, moreColumns ...string) builders.ExecutableBuilder {
 	rb := returningBuilder{
 		prevBuilder: rwb,
+		dialect:     rwb.dialect,
 	}
 	return rb.Returning(column, moreColumns...)
 }
@@ -186,5 +308,79 @@ type whereCondition struct {
 type whereConditions []whereCondition
 
 func (wc *whereConditions) Append(condition whereCondition) {
-	*wc = append(*wc, condition)
+	// The first condition doesn't need a conjunction since there is nothing preceding it
+	if len(*wc) == 0 {
+		condition.conjunction = ""
+	}
+	// Copy the conditions so that appending to them doesn't affect any other builder sharing the same backing array
+	*wc = append(slices.Clone(*wc), condition)
+}
+
+// isEmpty checks to see if every condition is one that should be skipped, in which case the clause should be omitted
+func (wc whereConditions) isEmpty() bool {
+	for _, cond := range wc {
+		if !incondition.IsEmpty(cond.condition) {
+			return false
+		}
+	}
+	return true
+}
+
+// grouped returns the conditions as a single grouped condition if any of them use "OR", so that another condition can be
+// added with "AND" and apply to all of them. Otherwise, the conditions are returned as they are.
+func (wc whereConditions) grouped() whereConditions {
+	hasOr := slices.ContainsFunc(wc, func(cond whereCondition) bool { return cond.conjunction == "OR" })
+	if !hasOr {
+		return wc
+	}
+
+	// "AND" takes precedence over "OR", so each run of conditions joined by "AND" becomes its own group
+	var orGroups []incondition.Condition
+	var andGroup []incondition.Condition
+	for _, cond := range wc {
+		if cond.conjunction == "OR" && len(andGroup) > 0 {
+			orGroups = append(orGroups, groupConditions("AND", andGroup))
+			andGroup = nil
+		}
+		andGroup = append(andGroup, cond.condition)
+	}
+	orGroups = append(orGroups, groupConditions("AND", andGroup))
+
+	return whereConditions{{condition: groupConditions("OR", orGroups)}}
+}
+
+// groupConditions returns the provided conditions joined by `conjunction`. A single condition is returned as it is
+func groupConditions(conjunction string, conds []incondition.Condition) incondition.Condition {
+	if len(conds) == 1 {
+		return conds[0]
+	}
+	return incondition.GroupedConditions{Conjunction: conjunction, Conditions: conds}
+}
+
+// parameterize returns the conditions joined by their conjunctions, along with the parameters of each condition.
+// Conditions that are not applicable are skipped.
+func (wc whereConditions) parameterize(d dialect.Dialect) (string, []any, error) {
+	sb := new(strings.Builder)
+	params := make([]any, 0)
+	for i, cond := range wc {
+		if incondition.IsEmpty(cond.condition) {
+			continue
+		}
+
+		condStr, condParams, err := cond.condition.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parameterize condition %d: %w", i, err)
+		}
+
+		params = append(params, condParams...)
+		// The first condition to be written doesn't need a conjunction, even if conditions before it were skipped
+		if cond.conjunction != "" && sb.Len() > 0 {
+			sb.WriteRune(' ')
+			sb.WriteString(cond.conjunction)
+			sb.WriteRune(' ')
+		}
+		sb.WriteString(condStr)
+	}
+
+	return sb.String(), params, nil
 }
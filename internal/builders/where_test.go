@@ -6,8 +6,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/williabk198/jagsqlb/builders"
 	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
 	incondition "github.com/williabk198/jagsqlb/internal/condition"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/join"
 	"github.com/williabk198/jagsqlb/types"
 )
 
@@ -26,7 +28,7 @@ func Test_whereBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Simple Conditions",
 			w: selectWhereBuilder{
-				mainQuery: NewSelectBuilder("table1 AS t1", "col1", "col2"),
+				mainQuery: NewSelectBuilder(nil, "table1 AS t1", "col1", "col2"),
 				conditions: []whereCondition{
 					{condition: condition.Equals("col1", "test")},
 					{condition: condition.NotBetween("col2", 10, 23), conjunction: "OR"},
@@ -41,7 +43,7 @@ func Test_whereBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Simple Conditions w/ ColumnValue",
 			w: selectWhereBuilder{
-				mainQuery: NewSelectBuilder("table1 AS t1").Table("table2 AS t2", "col1"),
+				mainQuery: NewSelectBuilder(nil, "table1 AS t1").Table("table2 AS t2", "col1"),
 				conditions: []whereCondition{
 					{condition: condition.Equals("t1.col1", incondition.ColumnValue{ColumnName: "t2.col2"})},
 				},
@@ -55,22 +57,38 @@ func Test_whereBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Grouped Conditions",
 			w: selectWhereBuilder{
-				mainQuery: NewSelectBuilder("table1", "*"),
+				mainQuery: NewSelectBuilder(nil, "table1", "*"),
 				conditions: []whereCondition{
 					{condition: condition.GroupedOr(condition.Equals("col1", "test"), condition.GreaterThanEqual("col2", 52))},
 					{condition: condition.GroupedOr(condition.NotIn("col3", []any{"test", "testing"}), condition.LessThan("col2", 52)), conjunction: "AND"},
 				},
 			},
 			wants: wants{
-				query:  `SELECT * FROM "table1" WHERE ("col1" = $1 OR "col2" >= $2) AND ("col3" NOT IN $3 OR "col2" < $4);`,
-				params: []any{"test", 52, []any{"test", "testing"}, 52},
+				query:  `SELECT * FROM "table1" WHERE ("col1" = $1 OR "col2" >= $2) AND ("col3" NOT IN ($3, $4) OR "col2" < $5);`,
+				params: []any{"test", 52, "test", "testing", 52},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(dialect.MySQL, "table1 AS t1", "col1"),
+				conditions: []whereCondition{
+					{condition: condition.Equals("t1.col1", "test")},
+					{condition: condition.LessThan("col2", 52), conjunction: "AND"},
+				},
+				dialect: dialect.MySQL,
+			},
+			wants: wants{
+				query:  "SELECT `col1` FROM `table1` AS `t1` WHERE `t1`.`col1` = ? AND `col2` < ?;",
+				params: []any{"test", 52},
 			},
 			assertion: assert.NoError,
 		},
 		{
 			name: "Success; Mixed Conditions",
 			w: selectWhereBuilder{
-				mainQuery: NewSelectBuilder("table1", "*"),
+				mainQuery: NewSelectBuilder(nil, "table1", "*"),
 				conditions: []whereCondition{
 					{condition: condition.GroupedAnd(condition.Equals("col1", "test"), condition.GreaterThanEqual("col2", 52))},
 					{condition: condition.LessThan("col3", 128), conjunction: "OR"},
@@ -82,6 +100,160 @@ func Test_whereBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; Subqueries",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(nil, "customers AS c", "*"),
+				conditions: []whereCondition{
+					{condition: condition.Equals("c.active", true)},
+					{
+						condition: condition.InQuery(
+							"c.id",
+							NewSelectBuilder(nil, "orders", "customer_id").Where(condition.GreaterThan("total", 100)),
+						),
+						conjunction: "AND",
+					},
+					{
+						condition: condition.NotExists(
+							NewSelectBuilder(nil, "bans AS b", "*").Where(
+								condition.Equals("b.customer_id", condition.ColumnValue("c.id")),
+								condition.GreaterThan("b.expires", "2025-01-01"),
+							),
+						),
+						conjunction: "AND",
+					},
+					{
+						condition:   condition.Equals("c.tier", NewSelectBuilder(nil, "tiers", "name").Where(condition.Equals("id", 3))),
+						conjunction: "AND",
+					},
+				},
+			},
+			wants: wants{
+				query: `SELECT * FROM "customers" AS "c" WHERE "c"."active" = $1` +
+					` AND "c"."id" IN (SELECT "customer_id" FROM "orders" WHERE "total" > $2)` +
+					` AND NOT EXISTS (SELECT * FROM "bans" AS "b" WHERE "b"."customer_id" = "c"."id" AND "b"."expires" > $3)` +
+					` AND "c"."tier" = (SELECT "name" FROM "tiers" WHERE "id" = $4);`,
+				params: []any{true, 100, "2025-01-01", 3},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Subquery SQL Server",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(dialect.SQLServer, "customers", "*"),
+				conditions: []whereCondition{
+					{
+						condition: condition.Exists(
+							NewSelectBuilder(dialect.SQLServer, "orders", "*").Where(condition.GreaterThan("total", 100)),
+						),
+					},
+					{condition: condition.Equals("active", true), conjunction: "AND"},
+				},
+				dialect: dialect.SQLServer,
+			},
+			wants: wants{
+				query:  `SELECT * FROM [customers] WHERE EXISTS (SELECT * FROM [orders] WHERE [total] > @p1) AND [active] = @p2;`,
+				params: []any{100, true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; In Lists",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(nil, "products", "*"),
+				conditions: []whereCondition{
+					{condition: condition.In("id", []any{4, 8, 15})},
+					{condition: condition.NotIn("category", []any{}), conjunction: "AND"},
+					{condition: condition.GroupedOr(condition.In("sku", []any{"a", "b"}), condition.IsNull("sku")), conjunction: "AND"},
+				},
+			},
+			wants: wants{
+				query:  `SELECT * FROM "products" WHERE "id" IN ($1, $2, $3) AND 1 = 1 AND ("sku" IN ($4, $5) OR "sku" IS NULL);`,
+				params: []any{4, 8, 15, "a", "b"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; In Lists as Arrays",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(nil, "products", "*"),
+				conditions: []whereCondition{
+					{condition: condition.In("id", []any{4, 8, 15})},
+					{condition: condition.NotIn("category", []any{"toys"}), conjunction: "AND"},
+				},
+				dialect: dialect.WithInListMode(dialect.Postgres, dialect.InListAny),
+			},
+			wants: wants{
+				query:  `SELECT * FROM "products" WHERE "id" = ANY($1) AND "category" != ALL($2);`,
+				params: []any{[]any{4, 8, 15}, []any{"toys"}},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Pattern Matching",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(nil, "products", "*"),
+				conditions: []whereCondition{
+					{condition: condition.Contains("name", "50%")},
+					{condition: condition.ILike("brand", "acme%"), conjunction: "OR"},
+				},
+			},
+			wants: wants{
+				query:  `SELECT * FROM "products" WHERE "name" LIKE $1 ESCAPE $2 OR "brand" ILIKE $3;`,
+				params: []any{`%50\%%`, `\`, "acme%"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Negated and Null-Safe Conditions",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(nil, "products", "*"),
+				conditions: []whereCondition{
+					{condition: condition.Not(condition.GroupedOr(condition.Equals("status", "retired"), condition.IsNull("sku")))},
+					{condition: condition.IsDistinctFrom("category", nil), conjunction: "AND"},
+				},
+			},
+			wants: wants{
+				query:  `SELECT * FROM "products" WHERE NOT ("status" = $1 OR "sku" IS NULL) AND "category" IS DISTINCT FROM $2;`,
+				params: []any{"retired", nil},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Pattern Matching MySQL",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(dialect.MySQL, "products", "*"),
+				conditions: []whereCondition{
+					{condition: condition.ILike("brand", "acme%")},
+				},
+				dialect: dialect.MySQL,
+			},
+			wants: wants{
+				query:  "SELECT * FROM `products` WHERE LOWER(`brand`) LIKE LOWER(?);",
+				params: []any{"acme%"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Subquery Build Failure",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(nil, "customers", "*"),
+				conditions: []whereCondition{
+					{condition: condition.InQuery("id", NewSelectBuilder(nil, ".orders", "customer_id"))},
+				},
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Subquery w/ Different Dialect",
+			w: selectWhereBuilder{
+				mainQuery: NewSelectBuilder(nil, "customers", "*"),
+				conditions: []whereCondition{
+					{condition: condition.InQuery("id", NewSelectBuilder(dialect.SQLServer, "orders", "customer_id"))},
+				},
+			},
+			assertion: assert.Error,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -93,6 +265,11 @@ func Test_whereBuilder_Build(t *testing.T) {
 	}
 }
 
+func Test_whereBuilder_ConditionError(t *testing.T) {
+	_, _, err := NewSelectBuilder(nil, "products", "*").Where(condition.Equals("active", true)).And(condition.FromStruct("bad")).Build()
+	assert.EqualError(t, err, "failed to parameterize condition 1: failed to parse filter struct: received value is not a struct type")
+}
+
 func Test_whereBuilder_And(t *testing.T) {
 	type args struct {
 		cond            incondition.Condition
@@ -314,7 +491,7 @@ func Test_returningWhereBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Simple Conditions",
 			rwb: returningWhereBuilder{
-				mainQuery: NewSelectBuilder("table1 AS t1", "col1", "col2"),
+				mainQuery: NewSelectBuilder(nil, "table1 AS t1", "col1", "col2"),
 				conditions: []whereCondition{
 					{condition: condition.Equals("col1", "test")},
 					{condition: condition.NotBetween("col2", 10, 23), conjunction: "OR"},
@@ -329,7 +506,7 @@ func Test_returningWhereBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Simple Conditions w/ ColumnValue",
 			rwb: returningWhereBuilder{
-				mainQuery: NewSelectBuilder("table1 AS t1").Table("table2 AS t2", "col1"),
+				mainQuery: NewSelectBuilder(nil, "table1 AS t1").Table("table2 AS t2", "col1"),
 				conditions: []whereCondition{
 					{condition: condition.Equals("t1.col1", incondition.ColumnValue{ColumnName: "t2.col2"})},
 				},
@@ -343,22 +520,22 @@ func Test_returningWhereBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Grouped Conditions",
 			rwb: returningWhereBuilder{
-				mainQuery: NewSelectBuilder("table1", "*"),
+				mainQuery: NewSelectBuilder(nil, "table1", "*"),
 				conditions: []whereCondition{
 					{condition: condition.GroupedOr(condition.Equals("col1", "test"), condition.GreaterThanEqual("col2", 52))},
 					{condition: condition.GroupedOr(condition.NotIn("col3", []any{"test", "testing"}), condition.LessThan("col2", 52)), conjunction: "AND"},
 				},
 			},
 			wants: wants{
-				query:  `SELECT * FROM "table1" WHERE ("col1" = $1 OR "col2" >= $2) AND ("col3" NOT IN $3 OR "col2" < $4);`,
-				params: []any{"test", 52, []any{"test", "testing"}, 52},
+				query:  `SELECT * FROM "table1" WHERE ("col1" = $1 OR "col2" >= $2) AND ("col3" NOT IN ($3, $4) OR "col2" < $5);`,
+				params: []any{"test", 52, "test", "testing", 52},
 			},
 			assertion: assert.NoError,
 		},
 		{
 			name: "Success; Mixed Conditions",
 			rwb: returningWhereBuilder{
-				mainQuery: NewSelectBuilder("table1", "*"),
+				mainQuery: NewSelectBuilder(nil, "table1", "*"),
 				conditions: []whereCondition{
 					{condition: condition.GroupedAnd(condition.Equals("col1", "test"), condition.GreaterThanEqual("col2", 52))},
 					{condition: condition.LessThan("col3", 128), conjunction: "OR"},
@@ -373,11 +550,10 @@ func Test_returningWhereBuilder_Build(t *testing.T) {
 		{
 			name: "Success; Update Statement",
 			rwb: returningWhereBuilder{
-				mainQuery: NewUpdateBuilder("table1").SetMap(map[string]any{"col1": "testing", "col2": 42}),
+				mainQuery: NewUpdateBuilder(nil, "table1").SetMap(map[string]any{"col1": "testing", "col2": 42}),
 				conditions: []whereCondition{
 					{condition: condition.Equals("id", "testID")},
 				},
-				existingParams: 2,
 			},
 			wants: wants{
 				query:  `UPDATE "table1" SET "col1"=$1, "col2"=$2 WHERE "id" = $3;`,
@@ -385,6 +561,41 @@ func Test_returningWhereBuilder_Build(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; Update From w/o Conditions",
+			rwb:  NewUpdateBuilder(nil, "table1").SetMap(map[string]any{"col1": "testing"}).From("table2").(returningWhereBuilder),
+			wants: wants{
+				query:  `UPDATE "table1" SET "col1"=$1 FROM "table2";`,
+				params: []any{"testing"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Update From w/ And",
+			rwb: NewUpdateBuilder(nil, "table1").SetMap(map[string]any{"col1": "testing"}).From("table2").And(
+				condition.Equals("table1.id", condition.ColumnValue("table2.id")),
+			).(returningWhereBuilder),
+			wants: wants{
+				query:  `UPDATE "table1" SET "col1"=$1 FROM "table2" WHERE "table1"."id" = "table2"."id";`,
+				params: []any{"testing"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Update Statement w/ Subquery",
+			rwb: returningWhereBuilder{
+				mainQuery: NewUpdateBuilder(nil, "table1").SetMap(map[string]any{"col1": "testing"}),
+				conditions: []whereCondition{
+					{condition: condition.InQuery("id", NewSelectBuilder(nil, "table2", "t1_id").Where(condition.Equals("col2", 42)))},
+					{condition: condition.NotEquals("col3", "test"), conjunction: "AND"},
+				},
+			},
+			wants: wants{
+				query:  `UPDATE "table1" SET "col1"=$1 WHERE "id" IN (SELECT "t1_id" FROM "table2" WHERE "col2" = $2) AND "col3" != $3;`,
+				params: []any{"testing", 42, "test"},
+			},
+			assertion: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -566,3 +777,134 @@ func Test_returningWhereBuilder_Returning(t *testing.T) {
 		})
 	}
 }
+
+func Test_whereConditions_SkipEmpty(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	var status *string
+	minTotal := 100
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Some Conditions Skipped",
+			builder: NewSelectBuilder(nil, "orders", "*").Where(
+				condition.NotZero("status", status),
+				condition.NotZero("total", &minTotal),
+			).Or(
+				condition.If(false, condition.IsNull("shipped_at")),
+				condition.GroupedAnd(condition.If(false, condition.Equals("a", 1)), condition.NotZero("b", "")),
+			),
+			wants: wants{
+				query:  `SELECT * FROM "orders" WHERE "total" = $1;`,
+				params: []any{100},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Filter Struct",
+			builder: NewSelectBuilder(nil, "orders", "*").Where(
+				condition.FromStruct(struct {
+					Status   *string `jagsqlb:"status"`
+					MinTotal int     `jagsqlb:"total;op=>="`
+					IDs      []int   `jagsqlb:"id"`
+				}{MinTotal: 100, IDs: []int{4, 8}}, condition.SkipZero),
+			),
+			wants: wants{
+				query:  `SELECT * FROM "orders" WHERE ("total" >= $1 AND "id" IN ($2, $3));`,
+				params: []any{100, 4, 8},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:    "Success; All Conditions Skipped",
+			builder: NewSelectBuilder(nil, "orders", "*").Where(condition.NotZero("status", status)).Limit(10),
+			wants: wants{
+				query: `SELECT * FROM "orders" LIMIT 10;`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Delete w/ All Conditions Skipped",
+			builder: NewDeleteBuilder(nil, "orders").Where(condition.If(false, condition.Equals("id", 7))).
+				Returning("id"),
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Update w/ All Conditions Skipped",
+			builder: NewUpdateBuilder(nil, "orders").Set("status", "void").
+				Where(condition.NotZero("id", 0), condition.If(false, condition.Equals("status", "open"))),
+			assertion: assert.Error,
+		},
+		{
+			name: "Success; Having w/ All Conditions Skipped",
+			builder: NewSelectBuilder(nil, "orders", "customer_id").GroupBy("customer_id").
+				Having(condition.If(false, condition.GreaterThan("customer_id", 5))),
+			wants: wants{
+				query: `SELECT "customer_id" FROM "orders" GROUP BY "customer_id";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Join On w/ Skipped Condition",
+			builder: NewSelectBuilder(nil, "orders AS o", "*").Join(join.TypeLeft, "customers AS c", join.On(
+				condition.Equals("o.customer_id", condition.ColumnValue("c.id")),
+				condition.NotZero("c.region", ""),
+			)),
+			wants: wants{
+				query: `SELECT "o".* FROM "orders" AS "o" LEFT JOIN "customers" AS "c" ON "o"."customer_id" = "c"."id";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Join On w/ All Conditions Skipped",
+			builder: NewSelectBuilder(nil, "orders AS o", "*").Join(join.TypeLeft, "customers AS c", join.On(
+				condition.NotZero("c.region", ""),
+			)),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func Test_whereBuilder_And_Branches(t *testing.T) {
+	// Three conditions leave room in the slice of conditions for a fourth without it needing to grow
+	base := NewSelectBuilder(nil, "t", "*").Where(condition.Equals("a", 1)).And(condition.Equals("b", 2)).And(condition.Equals("c", 9))
+
+	first := base.And(condition.Equals("x", 3))
+	second := base.And(condition.Equals("y", 4))
+
+	gotQuery, gotParams, err := first.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT * FROM "t" WHERE "a" = $1 AND "b" = $2 AND "c" = $3 AND "x" = $4;`, gotQuery)
+	assert.Equal(t, []any{1, 2, 9, 3}, gotParams)
+
+	gotQuery, gotParams, err = second.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT * FROM "t" WHERE "a" = $1 AND "b" = $2 AND "c" = $3 AND "y" = $4;`, gotQuery)
+	assert.Equal(t, []any{1, 2, 9, 4}, gotParams)
+}
+
+func Test_returningWhereBuilder_AllConditionsSkipped(t *testing.T) {
+	_, _, err := NewDeleteBuilder(nil, "orders").Where(condition.If(false, condition.Equals("id", 7))).Build()
+	assert.EqualError(t, err, "every condition of the WHERE clause was skipped, which would affect every row")
+
+	// Leaving out the WHERE clause entirely still affects every row
+	gotQuery, _, err := NewDeleteBuilder(nil, "orders").Build()
+	assert.NoError(t, err)
+	assert.Equal(t, `DELETE FROM "orders";`, gotQuery)
+}
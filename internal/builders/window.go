@@ -0,0 +1,173 @@
+package inbuilders
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+type namedWindow struct {
+	name       string
+	definition inexpr.Window
+}
+
+// windowBuilder implements `builders.WindowBuilder` and represents the WINDOW clause in a SELECT statement
+type windowBuilder struct {
+	precedingBuilder builders.Builder
+	windows          []namedWindow
+	dialect          dialect.Dialect
+	errs             intypes.ErrorSlice
+}
+
+func (wb windowBuilder) Build() (string, []any, error) {
+	return finalizeBuild(wb.dialect, wb)
+}
+
+func (wb windowBuilder) Exec(ctx context.Context, db builders.ExecerContext) (sql.Result, error) {
+	return Exec(ctx, db, wb)
+}
+
+func (wb windowBuilder) Query(ctx context.Context, db builders.QueryerContext) (*sql.Rows, error) {
+	return Query(ctx, db, wb)
+}
+
+func (wb windowBuilder) QueryRow(ctx context.Context, db builders.QueryerContext) builders.Row {
+	return QueryRow(ctx, db, wb)
+}
+
+func (wb windowBuilder) BuildRaw() (string, []any, error) {
+	if len(wb.errs) > 0 {
+		return "", nil, wb.errs
+	}
+
+	query, params, err := buildRaw(wb.precedingBuilder)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build preceding query: %w", err)
+	}
+
+	d := dialectOrDefault(wb.dialect)
+	sb := new(strings.Builder)
+	sb.WriteString(query[:len(query)-1])
+	sb.WriteString(" WINDOW ")
+
+	for i, window := range wb.windows {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		definition, definitionParams, err := window.definition.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to render definition of window %q: %w", window.name, err)
+		}
+		sb.WriteString(d.QuoteIdentifier(window.name))
+		sb.WriteString(" AS (")
+		sb.WriteString(definition)
+		sb.WriteRune(')')
+		params = append(params, definitionParams...)
+	}
+	sb.WriteRune(';')
+
+	return sb.String(), params, nil
+}
+
+// Dialect implements intypes.RawBuilder.
+func (wb windowBuilder) Dialect() dialect.Dialect {
+	return dialectOrDefault(wb.dialect)
+}
+
+func (wb windowBuilder) Window(name string, definition inexpr.Window) builders.WindowBuilder {
+	if name == "" {
+		wb.errs = append(slices.Clone(wb.errs), fmt.Errorf("no name was provided for the window definition"))
+		return wb
+	}
+
+	// Copy the windows so that appending to them doesn't affect any other builder sharing the same backing array
+	wb.windows = append(append([]namedWindow(nil), wb.windows...), namedWindow{name: name, definition: definition})
+	return wb
+}
+
+func (wb windowBuilder) CountQuery() builders.ExecutableBuilder {
+	return newCountBuilder(wb.dialect, wb)
+}
+
+func (wb windowBuilder) countRows() (builders.ExecutableBuilder, bool) {
+	// The named windows are only needed by the selected columns. So, they can be dropped as long as the selected columns
+	// are replaced. Otherwise, the query needs to be counted as it is.
+	c, ok := wb.precedingBuilder.(countable)
+	if !ok || len(wb.errs) > 0 {
+		return nil, false
+	}
+	return c.countRows()
+}
+
+func (wb windowBuilder) Union(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(wb.dialect, wb, compoundUnion, query)
+}
+
+func (wb windowBuilder) UnionAll(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(wb.dialect, wb, compoundUnionAll, query)
+}
+
+func (wb windowBuilder) Intersect(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(wb.dialect, wb, compoundIntersect, query)
+}
+
+func (wb windowBuilder) Except(query builders.Builder) builders.CompoundBuilder {
+	return newCompoundBuilder(wb.dialect, wb, compoundExcept, query)
+}
+
+func (wb windowBuilder) Limit(limit uint) builders.LockableBuilder {
+	return limitBuilder{
+		precedingBuilder: wb,
+		limit:            limit,
+		dialect:          wb.dialect,
+	}
+}
+
+func (wb windowBuilder) ForUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(wb.dialect, wb).ForUpdate(tables...)
+}
+
+func (wb windowBuilder) ForNoKeyUpdate(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(wb.dialect, wb).ForNoKeyUpdate(tables...)
+}
+
+func (wb windowBuilder) ForShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(wb.dialect, wb).ForShare(tables...)
+}
+
+func (wb windowBuilder) ForKeyShare(tables ...string) builders.RowLockBuilder {
+	return newRowLockBuilder(wb.dialect, wb).ForKeyShare(tables...)
+}
+
+func (wb windowBuilder) Offset(offset uint) builders.LimitBuilder {
+	return offsetBuilder{
+		precedingBuilder: wb,
+		offset:           offset,
+		dialect:          wb.dialect,
+	}
+}
+
+func (wb windowBuilder) OrderBy(ordering types.ColumnOrdering, moreOrderings ...types.ColumnOrdering) builders.OffsetBuilder {
+	return orderByBuilder{
+		precedingBuilder: wb,
+		columnOrderings:  append([]types.ColumnOrdering{ordering}, moreOrderings...),
+		dialect:          wb.dialect,
+	}
+}
+
+// newWindowBuilder creates a windowBuilder, without any window definitions, that follows `precedingBuilder`
+func newWindowBuilder(d dialect.Dialect, precedingBuilder builders.Builder) windowBuilder {
+	return windowBuilder{
+		precedingBuilder: precedingBuilder,
+		dialect:          d,
+	}
+}
@@ -0,0 +1,125 @@
+package inbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/expr"
+	"github.com/williabk198/jagsqlb/types"
+)
+
+func Test_windowBuilder_Build(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	salaryDesc := types.ColumnOrdering{ColumnName: "salary", Ordering: types.OrderingDescending}
+
+	tests := []struct {
+		name      string
+		builder   builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Inline Windows",
+			builder: NewSelectBuilder(nil, "employees", "name",
+				expr.RowNumber().Over(expr.PartitionBy("department").Order(salaryDesc)).As("n"),
+				expr.Sum("salary").Over(expr.Window().Order(salaryDesc).Rows(expr.UnboundedPreceding, expr.CurrentRow)).As("running"),
+			),
+			wants: wants{
+				query: `SELECT "name", ROW_NUMBER() OVER (PARTITION BY "department" ORDER BY "salary" DESC) AS "n", ` +
+					`SUM("salary") OVER (ORDER BY "salary" DESC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS "running" FROM "employees";`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Named Windows",
+			builder: NewSelectBuilder(nil, "employees", "name",
+				expr.Rank().Over(expr.NamedWindow("w")).As("rank"),
+				expr.Lag("salary", 1, 0).Over(expr.NamedWindow("w")).As("previous"),
+			).Where(condition.Equals("active", true)).
+				Window("w", expr.PartitionBy("department").Order(salaryDesc)).
+				OrderBy(types.ColumnOrdering{ColumnName: "name", Ordering: types.OrderingAscending}).
+				Limit(10),
+			wants: wants{
+				query: `SELECT "name", RANK() OVER "w" AS "rank", LAG("salary", $1, $2) OVER "w" AS "previous" FROM "employees" ` +
+					`WHERE "active" = $3 WINDOW "w" AS (PARTITION BY "department" ORDER BY "salary" DESC) ORDER BY "name" ASC LIMIT 10;`,
+				params: []any{1, 0, true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Multiple Windows After Having",
+			builder: NewSelectBuilder(nil, "orders", "customer_id",
+				expr.Sum(expr.Sum("total")).Over(expr.NamedWindow("w2")).As("cumulative"),
+			).GroupBy("customer_id").Having(condition.GreaterThan(expr.Count("*"), 2)).
+				Window("w1", expr.OrderBy(types.ColumnOrdering{ColumnName: "customer_id", Ordering: types.OrderingAscending})).
+				Window("w2", expr.NamedWindow("w1").Range(expr.Preceding(5), expr.Following(5))),
+			wants: wants{
+				query: `SELECT "customer_id", SUM(SUM("total")) OVER "w2" AS "cumulative" FROM "orders" GROUP BY "customer_id" HAVING COUNT(*) > $1 ` +
+					`WINDOW "w1" AS (ORDER BY "customer_id" ASC), "w2" AS ("w1" RANGE BETWEEN 5 PRECEDING AND 5 FOLLOWING);`,
+				params: []any{2},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL",
+			builder: NewSelectBuilder(dialect.MySQL, "employees", "name", expr.DenseRank().Over(expr.NamedWindow("w")).As("rank")).
+				Window("w", expr.PartitionBy(expr.Lower("department"))),
+			wants: wants{
+				query:  "SELECT `name`, DENSE_RANK() OVER `w` AS `rank` FROM `employees` WINDOW `w` AS (PARTITION BY LOWER(`department`));",
+				params: nil,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Window Params",
+			builder: NewSelectBuilder(nil, "employees", "name", expr.RowNumber().Over(expr.NamedWindow("w")).As("n")).
+				Where(condition.Equals("active", true)).
+				Window("w", expr.PartitionBy(expr.Coalesce("department", expr.Value("none")))),
+			wants: wants{
+				query:  `SELECT "name", ROW_NUMBER() OVER "w" AS "n" FROM "employees" WHERE "active" = $1 WINDOW "w" AS (PARTITION BY COALESCE("department", $2));`,
+				params: []any{true, "none"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL w/ Emulated Nulls",
+			builder: NewSelectBuilder(dialect.MySQL, "employees", "name", expr.Rank().Over(expr.NamedWindow("w")).As("rank")).
+				Window("w", expr.OrderBy(types.Desc("bonus").NullsLast())),
+			wants: wants{
+				query: "SELECT `name`, RANK() OVER `w` AS `rank` FROM `employees` " +
+					"WINDOW `w` AS (ORDER BY CASE WHEN `bonus` IS NULL THEN 1 ELSE 0 END, `bonus` DESC);",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Missing Name",
+			builder:   NewSelectBuilder(nil, "employees", "name").Window("", expr.Window()),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Definition",
+			builder:   NewSelectBuilder(nil, "employees", "name").Window("w", expr.PartitionBy(".department")),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Preceding Builder",
+			builder:   NewSelectBuilder(nil, ".employees", "name").Window("w", expr.Window()),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.builder.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package inbuilders
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+// commonTableExpression is a named query defined within the WITH clause that precedes a statement
+type commonTableExpression struct {
+	name      string
+	columns   []string
+	query     builders.Builder
+	recursive bool
+}
+
+// withClause is the list of common table expressions that precede a statement
+type withClause []commonTableExpression
+
+// prefix renders the WITH clause in front of the provided query. The parameters of the common table expressions are
+// placed before the parameters of the query since they appear first. If there are no common table expressions, then the
+// query and parameters are returned unchanged.
+func (wc withClause) prefix(d dialect.Dialect, query string, params []any) (string, []any, error) {
+	if len(wc) == 0 {
+		return query, params, nil
+	}
+
+	sb := new(strings.Builder)
+	sb.WriteString("WITH ")
+	for _, cte := range wc {
+		if cte.recursive && d.Supports(dialect.ClauseWithRecursive) {
+			sb.WriteString("RECURSIVE ")
+			break
+		}
+	}
+
+	var withParams []any
+	for i, cte := range wc {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString(d.QuoteIdentifier(cte.name))
+		if len(cte.columns) > 0 {
+			sb.WriteString(" (")
+			for j, column := range cte.columns {
+				if j > 0 {
+					sb.WriteString(", ")
+				}
+				sb.WriteString(d.QuoteIdentifier(column))
+			}
+			sb.WriteRune(')')
+		}
+
+		cteQuery, cteParams, err := intypes.BuildNested(d, cte.query)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to build common table expression %q: %w", cte.name, err)
+		}
+		sb.WriteString(" AS (")
+		sb.WriteString(strings.TrimSuffix(cteQuery, ";"))
+		sb.WriteRune(')')
+		withParams = append(withParams, cteParams...)
+	}
+	sb.WriteRune(' ')
+	sb.WriteString(query)
+
+	return sb.String(), append(withParams, params...), nil
+}
+
+// withOf returns the common table expressions that precede the provided SELECT statement, if there are any
+func withOf(b builders.Builder) withClause {
+	switch b := b.(type) {
+	case selectBuilder:
+		return b.with
+	case joinBuilder:
+		return b.selectBuilder.with
+	case selectWhereBuilder:
+		return withOf(b.mainQuery)
+	case groupByBuilder:
+		return withOf(b.precedingBuilder)
+	case havingBuilder:
+		return withOf(b.mainQuery)
+	case windowBuilder:
+		return withOf(b.precedingBuilder)
+	case compoundBuilder:
+		return withOf(b.firstQuery)
+	case orderByBuilder:
+		return withOf(b.precedingBuilder)
+	case offsetBuilder:
+		return withOf(b.precedingBuilder)
+	case limitBuilder:
+		return withOf(b.precedingBuilder)
+	case rowLockBuilder:
+		return withOf(b.precedingBuilder)
+	default:
+		return nil
+	}
+}
+
+// withBuilder implements `builders.WithBuilder` and collects the common table expressions for the statement that follows
+type withBuilder struct {
+	ctes    withClause
+	dialect dialect.Dialect
+	errs    intypes.ErrorSlice
+}
+
+// With implements builders.WithBuilder.
+func (wb withBuilder) With(name string, query builders.Builder, columns ...string) builders.WithBuilder {
+	return wb.addCTE(name, query, columns, false)
+}
+
+// WithRecursive implements builders.WithBuilder.
+func (wb withBuilder) WithRecursive(name string, query builders.Builder, columns ...string) builders.WithBuilder {
+	return wb.addCTE(name, query, columns, true)
+}
+
+// Delete implements builders.WithBuilder.
+func (wb withBuilder) Delete(table string) builders.DeleteBuilder {
+	db := NewDeleteBuilder(wb.dialect, table).(deleteBuilder)
+	db.with = wb.ctes
+	db.errs = append(slices.Clone(wb.errs), db.errs...)
+	return db
+}
+
+// Insert implements builders.WithBuilder.
+func (wb withBuilder) Insert(table string) builders.InsertBuilder {
+	ib := NewInsertBuilder(wb.dialect, table).(insertBuilder)
+	ib.with = wb.ctes
+	ib.errs = append(slices.Clone(wb.errs), ib.errs...)
+	return ib
+}
+
+// Select implements builders.WithBuilder.
+func (wb withBuilder) Select(table any, columns ...any) builders.SelectBuilder {
+	sb := selectBuilder{
+		dialect: wb.dialect,
+		with:    wb.ctes,
+		errs:    slices.Clone(wb.errs),
+	}
+	return sb.Table(table, columns...)
+}
+
+// Update implements builders.WithBuilder.
+func (wb withBuilder) Update(table string) builders.UpdateBuilder {
+	ub := NewUpdateBuilder(wb.dialect, table).(updateBuilder)
+	ub.with = wb.ctes
+	ub.errs = append(slices.Clone(wb.errs), ub.errs...)
+	return ub
+}
+
+// addCTE validates and appends a common table expression to the builder
+func (wb withBuilder) addCTE(name string, query builders.Builder, columns []string, recursive bool) withBuilder {
+	if name == "" || strings.ContainsAny(name, ". ") {
+		wb.errs = append(slices.Clone(wb.errs), fmt.Errorf("invalid common table expression name %q", name))
+		return wb
+	}
+
+	if query == nil {
+		wb.errs = append(slices.Clone(wb.errs), fmt.Errorf("no query was provided for common table expression %q", name))
+		return wb
+	}
+
+	wb.ctes = append(slices.Clone(wb.ctes), commonTableExpression{
+		name:      name,
+		columns:   columns,
+		query:     query,
+		recursive: recursive,
+	})
+	return wb
+}
+
+// NewWithBuilder creates a WithBuilder that renders its queries using the provided dialect.
+// If `d` is nil, then PostgreSQL will be used.
+func NewWithBuilder(d dialect.Dialect) builders.WithBuilder {
+	return withBuilder{dialect: d}
+}
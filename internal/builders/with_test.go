@@ -0,0 +1,304 @@
+package inbuilders
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/condition"
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/join"
+)
+
+func Test_withClause_prefix(t *testing.T) {
+	type args struct {
+		d      dialect.Dialect
+		query  string
+		params []any
+	}
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	recentOrders := NewSelectBuilder(nil, "orders", "*").Where(condition.GreaterThan("placed_at", "2025-01-01"))
+
+	tests := []struct {
+		name      string
+		wc        withClause
+		args      args
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; No CTEs",
+			args: args{
+				d:      dialect.Postgres,
+				query:  `SELECT * FROM "table1" WHERE "col1" = ?;`,
+				params: []any{42},
+			},
+			wants: wants{
+				query:  `SELECT * FROM "table1" WHERE "col1" = ?;`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Multiple CTEs",
+			wc: withClause{
+				{name: "recent_orders", query: recentOrders},
+				{name: "totals", columns: []string{"customer_id", "total"}, query: NewSelectBuilder(nil, "recent_orders", "customer_id", "price")},
+			},
+			args: args{
+				d:      dialect.Postgres,
+				query:  `SELECT * FROM "totals" WHERE "total" > ?;`,
+				params: []any{100},
+			},
+			wants: wants{
+				query: `WITH "recent_orders" AS (SELECT * FROM "orders" WHERE "placed_at" > ?),` +
+					` "totals" ("customer_id", "total") AS (SELECT "customer_id", "price" FROM "recent_orders")` +
+					` SELECT * FROM "totals" WHERE "total" > ?;`,
+				params: []any{"2025-01-01", 100},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Recursive",
+			wc: withClause{
+				{name: "recent_orders", query: recentOrders},
+				{name: "tree", query: NewSelectBuilder(nil, "nodes", "*"), recursive: true},
+			},
+			args: args{
+				d:     dialect.Postgres,
+				query: `SELECT * FROM "tree";`,
+			},
+			wants: wants{
+				query: `WITH RECURSIVE "recent_orders" AS (SELECT * FROM "orders" WHERE "placed_at" > ?),` +
+					` "tree" AS (SELECT * FROM "nodes") SELECT * FROM "tree";`,
+				params: []any{"2025-01-01"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Recursive SQL Server",
+			wc: withClause{
+				{name: "tree", query: NewSelectBuilder(dialect.SQLServer, "nodes", "*"), recursive: true},
+			},
+			args: args{
+				d:     dialect.SQLServer,
+				query: `SELECT * FROM [tree];`,
+			},
+			wants: wants{
+				query: `WITH [tree] AS (SELECT * FROM [nodes]) SELECT * FROM [tree];`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; CTE Build Failure",
+			wc: withClause{
+				{name: "bad", query: NewSelectBuilder(nil, ".table1", "*")},
+			},
+			args: args{
+				d:     dialect.Postgres,
+				query: `SELECT * FROM "bad";`,
+			},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.wc.prefix(tt.args.d, tt.args.query, tt.args.params)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func Test_withBuilder_With(t *testing.T) {
+	type args struct {
+		name    string
+		query   builders.Builder
+		columns []string
+	}
+
+	testQuery := NewSelectBuilder(nil, "table1", "*")
+
+	tests := []struct {
+		name string
+		wb   withBuilder
+		args args
+		want builders.WithBuilder
+	}{
+		{
+			name: "Success",
+			wb:   withBuilder{},
+			args: args{
+				name:    "cte1",
+				query:   testQuery,
+				columns: []string{"col1"},
+			},
+			want: withBuilder{
+				ctes: withClause{{name: "cte1", columns: []string{"col1"}, query: testQuery}},
+			},
+		},
+		{
+			name: "Error; Bad Name",
+			wb:   withBuilder{},
+			args: args{
+				name:  "schema.cte1",
+				query: testQuery,
+			},
+			want: withBuilder{
+				errs: intypes.ErrorSlice{fmt.Errorf("invalid common table expression name %q", "schema.cte1")},
+			},
+		},
+		{
+			name: "Error; Missing Query",
+			wb:   withBuilder{},
+			args: args{
+				name: "cte1",
+			},
+			want: withBuilder{
+				errs: intypes.ErrorSlice{fmt.Errorf("no query was provided for common table expression %q", "cte1")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.wb.With(tt.args.name, tt.args.query, tt.args.columns...))
+		})
+	}
+}
+
+func Test_withBuilder_WithRecursive(t *testing.T) {
+	testQuery := NewSelectBuilder(nil, "table1", "*")
+	want := withBuilder{
+		ctes: withClause{{name: "cte1", query: testQuery, recursive: true}},
+	}
+
+	assert.Equal(t, want, withBuilder{}.WithRecursive("cte1", testQuery))
+}
+
+func Test_withBuilder_Statements(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	recentOrders := NewSelectBuilder(nil, "orders", "id", "customer_id").Where(condition.GreaterThan("placed_at", "2025-01-01"))
+	wb := NewWithBuilder(nil).With("recent_orders", recentOrders)
+
+	tests := []struct {
+		name      string
+		b         builders.Builder
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Select w/ Join and Where",
+			b: wb.Select("customers AS c", "name").Join(
+				join.TypeInner,
+				"recent_orders AS ro",
+				join.On(condition.Equals("ro.customer_id", condition.ColumnValue("c.id"))),
+				"id",
+			).Where(condition.Equals("c.active", true)),
+			wants: wants{
+				query: `WITH "recent_orders" AS (SELECT "id", "customer_id" FROM "orders" WHERE "placed_at" > $1)` +
+					` SELECT "c"."name", "ro"."id" FROM "customers" AS "c" INNER JOIN "recent_orders" AS "ro" ON "ro"."customer_id" = "c"."id"` +
+					` WHERE "c"."active" = $2;`,
+				params: []any{"2025-01-01", true},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Insert",
+			b:    wb.Insert("audit").Values([]any{"checked"}),
+			wants: wants{
+				query:  `WITH "recent_orders" AS (SELECT "id", "customer_id" FROM "orders" WHERE "placed_at" > $1) INSERT INTO "audit" VALUES ($2);`,
+				params: []any{"2025-01-01", "checked"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Update w/ From and Where",
+			b: wb.Update("customers").SetMap(map[string]any{"recent": true}).From("recent_orders").And(
+				condition.Equals("customers.id", condition.ColumnValue("recent_orders.customer_id")),
+				condition.NotEquals("customers.name", "test"),
+			),
+			wants: wants{
+				query: `WITH "recent_orders" AS (SELECT "id", "customer_id" FROM "orders" WHERE "placed_at" > $1)` +
+					` UPDATE "customers" SET "recent"=$2 FROM "recent_orders"` +
+					` WHERE "customers"."id" = "recent_orders"."customer_id" AND "customers"."name" != $3;`,
+				params: []any{"2025-01-01", true, "test"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Delete w/ Using and Returning",
+			b: wb.Delete("orders").Using("recent_orders").Where(
+				condition.Equals("orders.id", condition.ColumnValue("recent_orders.id")),
+			).Returning("orders.id"),
+			wants: wants{
+				query: `WITH "recent_orders" AS (SELECT "id", "customer_id" FROM "orders" WHERE "placed_at" > $1)` +
+					` DELETE FROM "orders" USING "recent_orders" WHERE "orders"."id" = "recent_orders"."id" RETURNING "orders"."id";`,
+				params: []any{"2025-01-01"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "MySQL",
+			b: NewWithBuilder(dialect.MySQL).With(
+				"recent_orders",
+				NewSelectBuilder(dialect.MySQL, "orders", "*").Where(condition.GreaterThan("placed_at", "2025-01-01")),
+			).Select("recent_orders", "*").Limit(10),
+			wants: wants{
+				query:  "WITH `recent_orders` AS (SELECT * FROM `orders` WHERE `placed_at` > ?) SELECT * FROM `recent_orders` LIMIT 10;",
+				params: []any{"2025-01-01"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Bad CTE",
+			b:         NewWithBuilder(nil).With("", recentOrders).Select("customers", "*"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad CTE w/ Delete",
+			b:         NewWithBuilder(nil).With("", recentOrders).Delete("customers"),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; CTE w/ Different Dialect",
+			b:         NewWithBuilder(dialect.MySQL).With("recent_orders", recentOrders).Select("recent_orders", "*"),
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.b.Build()
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func Test_withBuilder_Select_Branches(t *testing.T) {
+	// Three failed CTEs leave room in the error slice for a fourth error without it needing to grow
+	recentOrders := NewSelectBuilder(nil, "orders", "*")
+	base := NewWithBuilder(nil).With("", recentOrders).With("", recentOrders).With("", recentOrders)
+
+	first := base.Select(".first", "*")
+	second := base.Select(".second", "*")
+
+	_, _, err := first.Build()
+	assert.ErrorContains(t, err, `".first"`)
+	assert.NotContains(t, err.Error(), `".second"`)
+
+	_, _, err = second.Build()
+	assert.ErrorContains(t, err, `".second"`)
+	assert.NotContains(t, err.Error(), `".first"`)
+}
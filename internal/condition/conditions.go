@@ -1,6 +1,9 @@
 package incondition
 
-import "github.com/williabk198/jagsqlb/internal/utilities/parsers"
+import (
+	"github.com/williabk198/jagsqlb/dialect"
+	"github.com/williabk198/jagsqlb/internal/utilities/parsers"
+)
 
 var (
 	columnParser = parsers.NewColumnParser()
@@ -8,7 +11,9 @@ var (
 
 // Condition is essentially the same as a Builder, but needed a way to differentiate a Condition from a Builder
 type Condition interface {
-	Parameterize() (string, []any, error)
+	// Parameterize returns the condition as a string with "?" in place of each of its values, along with the values themselves.
+	// Any identifiers are quoted using the provided dialect.
+	Parameterize(d dialect.Dialect) (string, []any, error)
 }
 
 // ColumnValue represents a column that will be uses as a value within a condition.
@@ -0,0 +1,38 @@
+package incondition
+
+import (
+	"github.com/williabk198/jagsqlb/dialect"
+)
+
+// EmptyCondition represents a condition that is not applicable. It is skipped by anything that it is provided to.
+type EmptyCondition struct{}
+
+func (EmptyCondition) Parameterize(dialect.Dialect) (string, []any, error) {
+	return "", nil, nil
+}
+
+// IsEmpty checks to see if the provided condition should be skipped. This is the case for a nil condition, an EmptyCondition
+// and any grouped or negated condition that only consists of empty conditions.
+func IsEmpty(cond Condition) bool {
+	switch c := cond.(type) {
+	case nil, EmptyCondition:
+		return true
+	case GroupedConditions:
+		return len(RemoveEmpty(c.Conditions)) == 0
+	case NotCondition:
+		return IsEmpty(c.Condition)
+	default:
+		return false
+	}
+}
+
+// RemoveEmpty returns the provided conditions without any of the ones that should be skipped
+func RemoveEmpty(conds []Condition) []Condition {
+	result := make([]Condition, 0, len(conds))
+	for _, cond := range conds {
+		if !IsEmpty(cond) {
+			result = append(result, cond)
+		}
+	}
+	return result
+}
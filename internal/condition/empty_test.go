@@ -0,0 +1,76 @@
+package incondition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+)
+
+func TestIsEmpty(t *testing.T) {
+	testCond := SimpleCondition{ColumnName: "col1", Operator: "=", Values: []any{42}}
+
+	tests := []struct {
+		name string
+		cond Condition
+		want bool
+	}{
+		{
+			name: "Nil Condition",
+			cond: nil,
+			want: true,
+		},
+		{
+			name: "Empty Condition",
+			cond: EmptyCondition{},
+			want: true,
+		},
+		{
+			name: "Simple Condition",
+			cond: testCond,
+			want: false,
+		},
+		{
+			name: "Grouped Empty Conditions",
+			cond: GroupedConditions{Conjunction: "AND", Conditions: []Condition{EmptyCondition{}, NotCondition{Condition: EmptyCondition{}}}},
+			want: true,
+		},
+		{
+			name: "Grouped Mixed Conditions",
+			cond: GroupedConditions{Conjunction: "AND", Conditions: []Condition{EmptyCondition{}, testCond}},
+			want: false,
+		},
+		{
+			name: "Negated Empty Condition",
+			cond: NotCondition{Condition: EmptyCondition{}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsEmpty(tt.cond))
+		})
+	}
+}
+
+func TestGroupedConditions_Parameterize_SkipEmpty(t *testing.T) {
+	gc := GroupedConditions{
+		Conjunction: "OR",
+		Conditions: []Condition{
+			EmptyCondition{},
+			SimpleCondition{ColumnName: "col1", Operator: "=", Values: []any{42}},
+			nil,
+			SimpleCondition{ColumnName: "col2", Operator: "<", Values: []any{7}},
+		},
+	}
+
+	gotQuery, gotParams, err := gc.Parameterize(dialect.Postgres)
+	assert.NoError(t, err)
+	assert.Equal(t, `("col1" = ? OR "col2" < ?)`, gotQuery)
+	assert.Equal(t, []any{42, 7}, gotParams)
+
+	gotQuery, gotParams, err = GroupedConditions{Conjunction: "AND", Conditions: []Condition{EmptyCondition{}}}.Parameterize(dialect.Postgres)
+	assert.NoError(t, err)
+	assert.Empty(t, gotQuery)
+	assert.Empty(t, gotParams)
+}
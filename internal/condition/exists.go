@@ -0,0 +1,23 @@
+package incondition
+
+import (
+	"fmt"
+
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+)
+
+// ExistsCondition represents a condition that checks whether or not a subquery returns any rows
+type ExistsCondition struct {
+	Operator string // This value should always be either "EXISTS" or "NOT EXISTS"
+	Subquery inexpr.Subquery
+}
+
+func (ec ExistsCondition) Parameterize(d dialect.Dialect) (string, []any, error) {
+	subqueryStr, params, err := ec.Subquery.Parameterize(d)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parameterize %s condition: %w", ec.Operator, err)
+	}
+
+	return fmt.Sprintf("%s %s", ec.Operator, subqueryStr), params, nil
+}
@@ -0,0 +1,88 @@
+package incondition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+)
+
+// testSubqueryBuilder is a minimal query builder used to test conditions that embed subqueries
+type testSubqueryBuilder struct {
+	rawQuery string
+	params   []any
+	err      error
+}
+
+func (tsb testSubqueryBuilder) Build() (string, []any, error) {
+	return "", nil, assert.AnError
+}
+
+func (tsb testSubqueryBuilder) BuildRaw() (string, []any, error) {
+	return tsb.rawQuery, tsb.params, tsb.err
+}
+
+func (tsb testSubqueryBuilder) Dialect() dialect.Dialect {
+	return dialect.Postgres
+}
+
+func TestExistsCondition_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		ec        ExistsCondition
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Exists",
+			ec: ExistsCondition{
+				Operator: "EXISTS",
+				Subquery: inexpr.Subquery{
+					Builder: testSubqueryBuilder{rawQuery: `SELECT * FROM "t1" WHERE "col1" = ?;`, params: []any{42}},
+				},
+			},
+			wants: wants{
+				query:  `EXISTS (SELECT * FROM "t1" WHERE "col1" = ?)`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Not Exists",
+			ec: ExistsCondition{
+				Operator: "NOT EXISTS",
+				Subquery: inexpr.Subquery{
+					Builder: testSubqueryBuilder{rawQuery: `SELECT * FROM "t1";`},
+				},
+			},
+			wants: wants{
+				query: `NOT EXISTS (SELECT * FROM "t1")`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Subquery Build Failure",
+			ec: ExistsCondition{
+				Operator: "EXISTS",
+				Subquery: inexpr.Subquery{
+					Builder: testSubqueryBuilder{err: assert.AnError},
+				},
+			},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.ec.Parameterize(dialect.Postgres)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
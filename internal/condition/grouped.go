@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/williabk198/jagsqlb/dialect"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
@@ -12,14 +13,20 @@ type GroupedConditions struct {
 	Conditions  []Condition
 }
 
-func (gc GroupedConditions) Parameterize() (string, []any, error) {
+func (gc GroupedConditions) Parameterize(d dialect.Dialect) (string, []any, error) {
+	// Skip any conditions that are not applicable. If none are left, then there is nothing to group
+	gc.Conditions = RemoveEmpty(gc.Conditions)
+	if len(gc.Conditions) == 0 {
+		return "", nil, nil
+	}
+
 	sb := new(strings.Builder)
 	resultParams := make([]any, 0)
 	errs := make(intypes.ErrorSlice, 0)
 
 	sb.WriteRune('(')
 
-	str, err := gc.parameterizeHelper(gc.Conditions[0], &resultParams)
+	str, err := gc.parameterizeHelper(d, gc.Conditions[0], &resultParams)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("failed to parameterize sub-condition %q: %w", gc.Conditions[0], err))
 	}
@@ -31,7 +38,7 @@ func (gc GroupedConditions) Parameterize() (string, []any, error) {
 		sb.WriteString(gc.Conjunction)
 		sb.WriteRune(' ')
 
-		str, err = gc.parameterizeHelper(gc.Conditions[i], &resultParams)
+		str, err = gc.parameterizeHelper(d, gc.Conditions[i], &resultParams)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to parameterize sub-condition %q: %w", gc.Conditions[i], err))
 			continue
@@ -48,21 +55,13 @@ func (gc GroupedConditions) Parameterize() (string, []any, error) {
 	return sb.String(), resultParams, nil
 }
 
-func (gc GroupedConditions) parameterizeHelper(cond Condition, currParams *[]any) (string, error) {
-	str, params, err := cond.Parameterize()
+func (gc GroupedConditions) parameterizeHelper(d dialect.Dialect, cond Condition, currParams *[]any) (string, error) {
+	str, params, err := cond.Parameterize(d)
 	if err != nil {
 		return "", err
 	}
 
-	// If the condition is a grouped condition or the parameterized string doesn't represent an "IN" condition,
-	// then append each element in `params` to the current slice of query parameters
-	if _, ok := cond.(GroupedConditions); ok || !strings.Contains(str, " IN ") {
-		*currParams = append(*currParams, params...)
-	} else {
-		// Otherwise, this condition represents an "IN" SimpleCondition, and we want to append the slice as a single
-		// element to the slice of current query parameters
-		*currParams = append(*currParams, params)
-	}
+	*currParams = append(*currParams, params...)
 
 	return str, nil
 }
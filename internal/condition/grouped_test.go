@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
 )
 
 func TestGroupedConditions_Parameterize(t *testing.T) {
@@ -55,8 +57,8 @@ func TestGroupedConditions_Parameterize(t *testing.T) {
 				Conditions:  []Condition{testCond1, testCond2},
 			},
 			wants: wants{
-				query:  `("t1"."col1" NOT IN ? AND "col2" BETWEEN ? AND ?)`,
-				params: []any{[]any{"test", "testing", "tester"}, 42, 56},
+				query:  `("t1"."col1" NOT IN (?, ?, ?) AND "col2" BETWEEN ? AND ?)`,
+				params: []any{"test", "testing", "tester", 42, 56},
 			},
 			assertion: assert.NoError,
 		},
@@ -67,8 +69,29 @@ func TestGroupedConditions_Parameterize(t *testing.T) {
 				Conditions:  []Condition{testGroupCond1, testCond1, testCond4},
 			},
 			wants: wants{
-				query:  `(("col2" BETWEEN ? AND ? OR "col3" != ?) AND "t1"."col1" NOT IN ? AND "col4" >= ?)`,
-				params: []any{42, 56, 23, []any{"test", "testing", "tester"}, 12.34},
+				query:  `(("col2" BETWEEN ? AND ? OR "col3" != ?) AND "t1"."col1" NOT IN (?, ?, ?) AND "col4" >= ?)`,
+				params: []any{42, 56, 23, "test", "testing", "tester", 12.34},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Grouped AND with Subquery",
+			gc: GroupedConditions{
+				Conjunction: "AND",
+				Conditions: []Condition{
+					SimpleCondition{
+						ColumnName: "col1",
+						Operator:   "IN",
+						Values: []any{inexpr.Subquery{
+							Builder: testSubqueryBuilder{rawQuery: `SELECT "col1" FROM "t2" WHERE "col2" IN ?;`, params: []any{[]any{1, 2}}},
+						}},
+					},
+					testCond1,
+				},
+			},
+			wants: wants{
+				query:  `("col1" IN (SELECT "col1" FROM "t2" WHERE "col2" IN ?) AND "t1"."col1" NOT IN (?, ?, ?))`,
+				params: []any{[]any{1, 2}, "test", "testing", "tester"},
 			},
 			assertion: assert.NoError,
 		},
@@ -91,8 +114,8 @@ func TestGroupedConditions_Parameterize(t *testing.T) {
 				Conditions:  []Condition{testCond2, testGroupCond2, testCond3},
 			},
 			wants: wants{
-				query:  `("col2" BETWEEN ? AND ? OR ("t1"."col1" NOT IN ? AND "col4" >= ?) OR "col3" != ?)`,
-				params: []any{42, 56, []any{"test", "testing", "tester"}, 12.34, 23},
+				query:  `("col2" BETWEEN ? AND ? OR ("t1"."col1" NOT IN (?, ?, ?) AND "col4" >= ?) OR "col3" != ?)`,
+				params: []any{42, 56, "test", "testing", "tester", 12.34, 23},
 			},
 			assertion: assert.NoError,
 		},
@@ -110,7 +133,7 @@ func TestGroupedConditions_Parameterize(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotQuery, gotParams, err := tt.gc.Parameterize()
+			gotQuery, gotParams, err := tt.gc.Parameterize(dialect.Postgres)
 			tt.assertion(t, err)
 			assert.Equal(t, tt.wants.query, gotQuery)
 			assert.Equal(t, tt.wants.params, gotParams)
@@ -0,0 +1,30 @@
+package incondition
+
+import (
+	"fmt"
+
+	"github.com/williabk198/jagsqlb/dialect"
+)
+
+// NotCondition represents the negation of another condition
+type NotCondition struct {
+	Condition Condition
+}
+
+func (nc NotCondition) Parameterize(d dialect.Dialect) (string, []any, error) {
+	// There is nothing to negate if the condition is not applicable
+	if IsEmpty(nc.Condition) {
+		return "", nil, nil
+	}
+
+	condStr, params, err := nc.Condition.Parameterize(d)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parameterize negated condition: %w", err)
+	}
+
+	// Grouped conditions are already surrounded by parentheses
+	if _, ok := nc.Condition.(GroupedConditions); ok {
+		return "NOT " + condStr, params, nil
+	}
+	return fmt.Sprintf("NOT (%s)", condStr), params, nil
+}
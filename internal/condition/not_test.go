@@ -0,0 +1,91 @@
+package incondition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+)
+
+func TestNotCondition_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		nc        NotCondition
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Simple Condition",
+			nc: NotCondition{
+				Condition: SimpleCondition{ColumnName: "col1", Operator: "=", Values: []any{42}},
+			},
+			wants: wants{
+				query:  `NOT ("col1" = ?)`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Grouped Conditions",
+			nc: NotCondition{
+				Condition: GroupedConditions{
+					Conjunction: "OR",
+					Conditions: []Condition{
+						SimpleCondition{ColumnName: "col1", Operator: "=", Values: []any{42}},
+						SimpleCondition{ColumnName: "col2", Operator: "IS", Values: []any{"NULL"}},
+					},
+				},
+			},
+			wants: wants{
+				query:  `NOT ("col1" = ? OR "col2" IS NULL)`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Exists Condition",
+			nc: NotCondition{
+				Condition: ExistsCondition{
+					Operator: "EXISTS",
+					Subquery: inexpr.Subquery{Builder: testSubqueryBuilder{rawQuery: `SELECT * FROM "t1";`}},
+				},
+			},
+			wants: wants{
+				query: `NOT (EXISTS (SELECT * FROM "t1"))`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Double Negation",
+			nc: NotCondition{
+				Condition: NotCondition{Condition: SimpleCondition{ColumnName: "col1", Operator: "<", Values: []any{7}}},
+			},
+			wants: wants{
+				query:  `NOT (NOT ("col1" < ?))`,
+				params: []any{7},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Bad Condition",
+			nc: NotCondition{
+				Condition: SimpleCondition{ColumnName: ".col1", Operator: "=", Values: []any{42}},
+			},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.nc.Parameterize(dialect.Postgres)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
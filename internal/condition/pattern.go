@@ -0,0 +1,85 @@
+package incondition
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+// PatternCondition represents a condition that matches the left-hand side against a pattern, such as "LIKE" or "~".
+// The pattern is the only value held by the embedded SimpleCondition.
+type PatternCondition struct {
+	SimpleCondition
+
+	// EscapeChar is the character used to escape wildcards within the pattern. No "ESCAPE" clause is rendered when it is zero.
+	EscapeChar rune
+}
+
+// Escape sets the character that is used to escape wildcards within the pattern
+func (pc PatternCondition) Escape(char rune) PatternCondition {
+	pc.EscapeChar = char
+	return pc
+}
+
+func (pc PatternCondition) Parameterize(d dialect.Dialect) (string, []any, error) {
+	columnStr, params, err := pc.renderColumn(d)
+	if err != nil {
+		return "", nil, err
+	}
+
+	patternStr := "?"
+	patternParams := pc.Values[:1]
+	if valueExpr, ok := valueExpression(pc.Values[0]); ok {
+		patternStr, patternParams, err = valueExpr.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parameterize pattern: %w", err)
+		}
+	}
+
+	operator := pc.Operator
+	switch operator {
+	case "ILIKE", "NOT ILIKE":
+		// Dialects without "ILIKE" can still match without regard to case by converting both sides to lower case
+		if !d.Supports(dialect.ClauseILike) {
+			operator = strings.TrimSuffix(operator, "ILIKE") + "LIKE"
+			columnStr = "LOWER(" + columnStr + ")"
+			patternStr = "LOWER(" + patternStr + ")"
+		}
+	case "SIMILAR TO", "NOT SIMILAR TO":
+		if !d.Supports(dialect.ClauseSimilarTo) {
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseSimilarTo)
+		}
+	case "~", "~*":
+		if !d.Supports(dialect.ClauseRegex) {
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseRegex)
+		}
+		if pc.EscapeChar != 0 {
+			return "", nil, fmt.Errorf("an escape character cannot be used with the %q operator", operator)
+		}
+	}
+
+	params = append(slices.Clone(params), patternParams...)
+	query := fmt.Sprintf("%s %s %s", columnStr, operator, patternStr)
+	if pc.EscapeChar != 0 {
+		query += " ESCAPE ?"
+		params = append(params, string(pc.EscapeChar))
+	}
+
+	return query, params, nil
+}
+
+// EscapePattern escapes the wildcards of "LIKE" patterns("%" and "_"), as well as `escapeChar` itself, within `value`
+// so that each of its characters is matched literally
+func EscapePattern(value string, escapeChar rune) string {
+	sb := new(strings.Builder)
+	for _, char := range value {
+		if char == '%' || char == '_' || char == escapeChar {
+			sb.WriteRune(escapeChar)
+		}
+		sb.WriteRune(char)
+	}
+	return sb.String()
+}
@@ -0,0 +1,181 @@
+package incondition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+)
+
+func TestPatternCondition_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	newPattern := func(column, operator string, pattern any) PatternCondition {
+		return PatternCondition{
+			SimpleCondition: SimpleCondition{ColumnName: column, Operator: operator, Values: []any{pattern}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		pc        PatternCondition
+		d         dialect.Dialect
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Like",
+			pc:   newPattern("name", "LIKE", "Jo%"),
+			d:    dialect.Postgres,
+			wants: wants{
+				query:  `"name" LIKE ?`,
+				params: []any{"Jo%"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Not Like w/ Escape",
+			pc:   newPattern("t1.code", "NOT LIKE", "50!%%").Escape('!'),
+			d:    dialect.Postgres,
+			wants: wants{
+				query:  `"t1"."code" NOT LIKE ? ESCAPE ?`,
+				params: []any{"50!%%", "!"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Like w/ ColumnValue",
+			pc:   newPattern("name", "LIKE", ColumnValue{ColumnName: "t2.pattern"}),
+			d:    dialect.Postgres,
+			wants: wants{
+				query: `"name" LIKE "t2"."pattern"`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Like w/ Expression Column",
+			pc: PatternCondition{
+				SimpleCondition: SimpleCondition{
+					Expression: inexpr.Function{Name: "CONCAT", Args: []any{"first_name", inexpr.Value{Value: " "}, "last_name"}},
+					Operator:   "LIKE",
+					Values:     []any{"%Smith"},
+				},
+			},
+			d: dialect.Postgres,
+			wants: wants{
+				query:  `CONCAT("first_name", ?, "last_name") LIKE ?`,
+				params: []any{" ", "%Smith"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; ILike",
+			pc:   newPattern("name", "ILIKE", "jo%"),
+			d:    dialect.Postgres,
+			wants: wants{
+				query:  `"name" ILIKE ?`,
+				params: []any{"jo%"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Not ILike w/o Dialect Support",
+			pc:   newPattern("name", "NOT ILIKE", "jo%").Escape('\\'),
+			d:    dialect.MySQL,
+			wants: wants{
+				query:  "LOWER(`name`) NOT LIKE LOWER(?) ESCAPE ?",
+				params: []any{"jo%", "\\"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Similar To",
+			pc:   newPattern("name", "SIMILAR TO", "%(b|d)%"),
+			d:    dialect.Postgres,
+			wants: wants{
+				query:  `"name" SIMILAR TO ?`,
+				params: []any{"%(b|d)%"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Regex",
+			pc:   newPattern("email", "~*", `@example\.com$`),
+			d:    dialect.Postgres,
+			wants: wants{
+				query:  `"email" ~* ?`,
+				params: []any{`@example\.com$`},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Similar To Unsupported by Dialect",
+			pc:        newPattern("name", "SIMILAR TO", "%(b|d)%"),
+			d:         dialect.SQLite,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Regex Unsupported by Dialect",
+			pc:        newPattern("name", "~", "^a"),
+			d:         dialect.MySQL,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Regex w/ Escape",
+			pc:        newPattern("name", "~", "^a").Escape('!'),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Column",
+			pc:        newPattern(".name", "LIKE", "a%"),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.pc.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestEscapePattern(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		escapeChar rune
+		want       string
+	}{
+		{
+			name:       "No Wildcards",
+			value:      "hello",
+			escapeChar: '\\',
+			want:       "hello",
+		},
+		{
+			name:       "Wildcards and Escape Char",
+			value:      `100%_a\b`,
+			escapeChar: '\\',
+			want:       `100\%\_a\\b`,
+		},
+		{
+			name:       "Custom Escape Char",
+			value:      "50%!",
+			escapeChar: '!',
+			want:       "50!%!!",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EscapePattern(tt.value, tt.escapeChar))
+		})
+	}
+}
@@ -3,43 +3,50 @@ package incondition
 import (
 	"fmt"
 	"strings"
+
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
 type SimpleCondition struct {
 	ColumnName string
 	Operator   string
 	Values     []any
+
+	// Expression is used as the left-hand side of the condition in place of ColumnName when it is not nil
+	Expression intypes.Expression
 }
 
-func (sc SimpleCondition) Parameterize() (string, []any, error) {
-	column, err := columnParser.Parse(sc.ColumnName)
+func (sc SimpleCondition) Parameterize(d dialect.Dialect) (string, []any, error) {
+	columnStr, columnParams, err := sc.renderColumn(d)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to parse column data: %w", err)
+		return "", nil, err
 	}
 
 	// Check to see if the is an IS or IS NOT condition.
-	if strings.HasPrefix(sc.Operator, "IS") {
+	if sc.Operator == "IS" || sc.Operator == "IS NOT" {
 		// If it is, then don't parameterize the value since it will always be "NULL"
-		return fmt.Sprintf("%s %s %s", column, sc.Operator, sc.Values[0]), nil, nil
+		return fmt.Sprintf("%s %s %s", columnStr, sc.Operator, sc.Values[0]), columnParams, nil
 	}
 
 	// TODO?: Move to its own function??
 	if strings.HasSuffix(sc.Operator, "BETWEEN") {
 		sb := new(strings.Builder)
-		sb.WriteString(column.String())
+		sb.WriteString(columnStr)
 		sb.WriteRune(' ')
 		sb.WriteString(sc.Operator)
 		sb.WriteRune(' ')
 
-		// Check to see if the first value is a ColumnValue
-		if columnVal, ok := sc.Values[0].(ColumnValue); ok {
-			// If so, then parse out the column data and append it to the builder
-			col, err := columnParser.Parse(columnVal.ColumnName)
+		// Check to see if the first value is a ColumnValue or an expression
+		if valueExpr, ok := valueExpression(sc.Values[0]); ok {
+			// If so, then render it and append it to the builder along with any of its parameters
+			exprStr, exprParams, err := valueExpr.Parameterize(d)
 			if err != nil {
-				return "", nil, fmt.Errorf("failed to parse ColumnValue")
+				return "", nil, fmt.Errorf("failed to parameterize BETWEEN value: %w", err)
 			}
-			sb.WriteString(col.String())
-			sc.Values = sc.Values[1:]
+			sb.WriteString(exprStr)
+			sc.Values = append(exprParams, sc.Values[1:]...)
 		} else {
 			// Otherwise, the value will be parameterized
 			sb.WriteString("?")
@@ -47,48 +54,164 @@ func (sc SimpleCondition) Parameterize() (string, []any, error) {
 
 		sb.WriteString(" AND ")
 
-		// Check to see if the last value is a ColumnValue
-		if columnVal, ok := sc.Values[len(sc.Values)-1].(ColumnValue); ok {
+		// Check to see if the last value is a ColumnValue or an expression
+		lastIndex := len(sc.Values) - 1
+		if valueExpr, ok := valueExpression(sc.Values[lastIndex]); ok {
 			// If so, do the same thing as above
-			col, err := columnParser.Parse(columnVal.ColumnName)
+			exprStr, exprParams, err := valueExpr.Parameterize(d)
 			if err != nil {
-				return "", nil, fmt.Errorf("failed to parse ColumnValue")
+				return "", nil, fmt.Errorf("failed to parameterize BETWEEN value: %w", err)
 			}
-			sb.WriteString(col.String())
-			sc.Values = sc.Values[:len(sc.Values)-1]
+			sb.WriteString(exprStr)
+			sc.Values = append(sc.Values[:lastIndex:lastIndex], exprParams...)
 		} else {
-			// Likewise here. Just parameterize the value if it isn't a ColumnValue
+			// Likewise here. Just parameterize the value if it isn't a ColumnValue or an expression
 			sb.WriteString("?")
 		}
 
-		return sb.String(), sc.Values, nil
+		return sb.String(), prependParams(columnParams, sc.Values), nil
 	}
 
-	// Check to see if the first value is a ColumnValue and is not an "IN" condition
+	// Check to see if this is an "IN" condition on a subquery. If so, the subquery is embedded rather than parameterized
 	inOperation := strings.HasSuffix(sc.Operator, "IN")
-	if columnVal, ok := sc.Values[0].(ColumnValue); ok && !inOperation {
-		// If so, parse out the column data and use it in the returned string
-		columnValStr, err := columnParser.Parse(columnVal.ColumnName)
+	if inOperation && !sc.isInList() {
+		subqueryStr, subqueryParams, err := sc.Values[0].(inexpr.Subquery).Parameterize(d)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("%s %s %s", columnStr, sc.Operator, subqueryStr), prependParams(columnParams, subqueryParams), nil
+	}
+
+	if inOperation {
+		return sc.parameterizeInList(d, columnStr, columnParams)
+	}
+
+	operator, format := sc.Operator, "%s %s %s"
+	if strings.HasSuffix(sc.Operator, "DISTINCT FROM") && !d.Supports(dialect.ClauseIsDistinctFrom) {
+		if !d.Supports(dialect.ClauseNullSafeEqual) {
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseIsDistinctFrom)
+		}
+
+		// "<=>" is the null-safe equivalent of "IS NOT DISTINCT FROM", so it must be negated for "IS DISTINCT FROM"
+		operator = string(dialect.ClauseNullSafeEqual)
+		if sc.Operator == "IS DISTINCT FROM" {
+			format = "NOT (%s %s %s)"
+		}
+	}
+
+	// Check to see if the first value is a ColumnValue or an expression
+	if valueExpr, ok := valueExpression(sc.Values[0]); ok {
+		// If so, render it and use it in the returned string
+		exprStr, exprParams, err := valueExpr.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parameterize value: %w", err)
+		}
+		return fmt.Sprintf(format, columnStr, operator, exprStr), prependParams(columnParams, append(sc.Values[1:], exprParams...)), nil
+	}
+	return fmt.Sprintf(format, columnStr, operator, "?"), prependParams(columnParams, sc.Values), nil
+}
+
+// parameterizeInList renders an "IN" condition on a list of values, using the InListMode of the provided dialect
+func (sc SimpleCondition) parameterizeInList(d dialect.Dialect, columnStr string, columnParams []any) (string, []any, error) {
+	negated := strings.HasPrefix(sc.Operator, "NOT")
+
+	// A value can never be within an empty list, so the condition is replaced with one that is always false,
+	// or always true when negated. Rendering "IN ()" would otherwise result in invalid SQL.
+	if len(sc.Values) == 0 {
+		if negated {
+			return "1 = 1", nil, nil
+		}
+		return "1 = 0", nil, nil
+	}
+
+	// If the slice of values contains a ColumnValue, an expression or a subquery, then throw an error.
+	// The value will be treated as a parameter; leading to unwanted results.
+	if containsValueExpression(sc.Values) {
+		return "", nil, fmt.Errorf("cannot have a ColumnValue or an expression within a parameterized IN condition")
+	}
+
+	if dialect.InListModeOf(d) == dialect.InListAny {
+		if !d.Supports(dialect.ClauseAnyArray) {
+			return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseAnyArray)
+		}
+
+		operator := "= ANY"
+		if negated {
+			operator = "!= ALL"
+		}
+		return fmt.Sprintf("%s %s(?)", columnStr, operator), prependParams(columnParams, []any{sc.Values}), nil
+	}
+
+	placeholders := make([]string, len(sc.Values))
+	for i := range placeholders {
+		placeholders[i] = "?"
+	}
+
+	return fmt.Sprintf("%s %s (%s)", columnStr, sc.Operator, strings.Join(placeholders, ", ")), prependParams(columnParams, sc.Values), nil
+}
+
+// isInList checks to see if the condition is an "IN" condition on a list of values rather than on a subquery
+func (sc SimpleCondition) isInList() bool {
+	if !strings.HasSuffix(sc.Operator, "IN") {
+		return false
+	}
+
+	if len(sc.Values) == 1 {
+		if _, ok := sc.Values[0].(inexpr.Subquery); ok {
+			return false
+		}
+	}
+	return true
+}
+
+// renderColumn returns the left-hand side of the condition along with any parameters it holds
+func (sc SimpleCondition) renderColumn(d dialect.Dialect) (string, []any, error) {
+	if sc.Expression != nil {
+		exprStr, exprParams, err := sc.Expression.Parameterize(d)
 		if err != nil {
-			return "", nil, fmt.Errorf("failed to parse ColumnValue data: %w", err)
+			return "", nil, fmt.Errorf("failed to parameterize condition expression: %w", err)
 		}
-		return fmt.Sprintf("%s %s %s", column, sc.Operator, columnValStr), sc.Values[1:], nil
+		return exprStr, exprParams, nil
+	}
+
+	column, err := columnParser.Parse(sc.ColumnName)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parse column data: %w", err)
 	}
+	return column.Render(d), nil, nil
+}
 
-	// If the slice of values contains a ColumnValue and this is an "IN" condition, then throw an error.
-	// The column will be treated as a string; leading to unwanted results.
-	if containsColumnValue(sc.Values) && inOperation {
-		return "", nil, fmt.Errorf("cannot have a ColumnValue within a parameterized IN condition")
+// valueExpression checks to see if the provided value should be rendered directly within the condition rather than
+// being parameterized. If it should be, then the value is returned as an expression. Query builders are embedded as subqueries.
+func valueExpression(val any) (intypes.Expression, bool) {
+	switch v := val.(type) {
+	case ColumnValue:
+		return inexpr.Column{Name: v.ColumnName}, true
+	case intypes.Ordering:
+		return inexpr.Invalid{Err: intypes.ErrMisplacedOrdering}, true
+	case intypes.Expression:
+		return v, true
+	case intypes.Builder:
+		return inexpr.Subquery{Builder: v}, true
+	default:
+		return nil, false
 	}
-	return fmt.Sprintf("%s %s ?", column, sc.Operator), sc.Values, nil
 }
 
-// containsColumnValue takes in a slice of values and checks to see if any are of the type ColumnValue
-func containsColumnValue(vals []any) bool {
+// containsValueExpression takes in a slice of values and checks to see if any are a ColumnValue or an expression
+func containsValueExpression(vals []any) bool {
 	for _, val := range vals {
-		if _, ok := val.(ColumnValue); ok {
+		if _, ok := valueExpression(val); ok {
 			return true
 		}
 	}
 	return false
 }
+
+// prependParams places the parameters of the condition's left-hand side ahead of the parameters of its values
+func prependParams(columnParams, valueParams []any) []any {
+	if len(columnParams) == 0 {
+		return valueParams
+	}
+	return append(columnParams, valueParams...)
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
 )
 
 func TestSimpleCondition_Parameterize(t *testing.T) {
@@ -142,7 +144,7 @@ func TestSimpleCondition_Parameterize(t *testing.T) {
 				Values:     []any{"test", "testing"},
 			},
 			wants: wants{
-				query:  `"col1" IN ?`,
+				query:  `"col1" IN (?, ?)`,
 				params: []any{"test", "testing"},
 			},
 			assertion: assert.NoError,
@@ -155,7 +157,7 @@ func TestSimpleCondition_Parameterize(t *testing.T) {
 				Values:     []any{"test", "testing"},
 			},
 			wants: wants{
-				query:  `"col1" NOT IN ?`,
+				query:  `"col1" NOT IN (?, ?)`,
 				params: []any{"test", "testing"},
 			},
 			assertion: assert.NoError,
@@ -225,6 +227,73 @@ func TestSimpleCondition_Parameterize(t *testing.T) {
 			},
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; Expression Column",
+			sc: SimpleCondition{
+				Expression: inexpr.Function{Name: "COALESCE", Args: []any{"col1", 0}},
+				Operator:   ">",
+				Values:     []any{100},
+			},
+			wants: wants{
+				query:  `COALESCE("col1", ?) > ?`,
+				params: []any{0, 100},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Expression Value",
+			sc: SimpleCondition{
+				ColumnName: "col1",
+				Operator:   "=",
+				Values:     []any{inexpr.Function{Name: "LOWER", Args: []any{inexpr.Value{Value: "TEST"}}}},
+			},
+			wants: wants{
+				query:  `"col1" = LOWER(?)`,
+				params: []any{"TEST"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Between with Expressions",
+			sc: SimpleCondition{
+				Expression: inexpr.Function{Name: "SUM", Args: []any{"col1"}},
+				Operator:   "BETWEEN",
+				Values:     []any{inexpr.Function{Name: "MIN", Args: []any{"col2"}}, 83},
+			},
+			wants: wants{
+				query:  `SUM("col1") BETWEEN MIN("col2") AND ?`,
+				params: []any{83},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; In Subquery",
+			sc: SimpleCondition{
+				ColumnName: "id",
+				Operator:   "IN",
+				Values: []any{inexpr.Subquery{
+					Builder: testSubqueryBuilder{rawQuery: `SELECT "t1_id" FROM "t2" WHERE "col1" > ?;`, params: []any{42}},
+				}},
+			},
+			wants: wants{
+				query:  `"id" IN (SELECT "t1_id" FROM "t2" WHERE "col1" > ?)`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Equals Subquery Builder",
+			sc: SimpleCondition{
+				ColumnName: "total",
+				Operator:   "=",
+				Values:     []any{testSubqueryBuilder{rawQuery: `SELECT MAX("total") FROM "t2" WHERE "col1" = ?;`, params: []any{"test"}}},
+			},
+			wants: wants{
+				query:  `"total" = (SELECT MAX("total") FROM "t2" WHERE "col1" = ?)`,
+				params: []any{"test"},
+			},
+			assertion: assert.NoError,
+		},
 		{
 			name: "Error; Bad Column Definition",
 			sc: SimpleCondition{
@@ -234,6 +303,33 @@ func TestSimpleCondition_Parameterize(t *testing.T) {
 			},
 			assertion: assert.Error,
 		},
+		{
+			name: "Error; Bad Expression Column",
+			sc: SimpleCondition{
+				Expression: inexpr.Column{Name: ".badColumn"},
+				Operator:   "=",
+				Values:     []any{"n/a"},
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; In Condition Contains Expression",
+			sc: SimpleCondition{
+				ColumnName: "col1",
+				Operator:   "IN",
+				Values:     []any{52, inexpr.Column{Name: "col2"}},
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Subquery Build Failure",
+			sc: SimpleCondition{
+				ColumnName: "id",
+				Operator:   "NOT IN",
+				Values:     []any{inexpr.Subquery{Builder: testSubqueryBuilder{err: assert.AnError}}},
+			},
+			assertion: assert.Error,
+		},
 		{
 			name: "Error; In Condition Contains ColumnValue",
 			sc: SimpleCondition{
@@ -246,7 +342,177 @@ func TestSimpleCondition_Parameterize(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotQuery, gotParams, err := tt.sc.Parameterize()
+			gotQuery, gotParams, err := tt.sc.Parameterize(dialect.Postgres)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestSimpleCondition_Parameterize_InList(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	postgresAny := dialect.WithInListMode(dialect.Postgres, dialect.InListAny)
+
+	tests := []struct {
+		name      string
+		sc        SimpleCondition
+		d         dialect.Dialect
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Expanded",
+			sc:   SimpleCondition{ColumnName: "col1", Operator: "IN", Values: []any{1, 2, 3}},
+			d:    dialect.MySQL,
+			wants: wants{
+				query:  "`col1` IN (?, ?, ?)",
+				params: []any{1, 2, 3},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Expanded w/ Expression Column",
+			sc: SimpleCondition{
+				Expression: inexpr.Function{Name: "COALESCE", Args: []any{"col1", inexpr.Value{Value: 0}}},
+				Operator:   "NOT IN",
+				Values:     []any{1, 2},
+			},
+			d: dialect.SQLite,
+			wants: wants{
+				query:  `COALESCE("col1", ?) NOT IN (?, ?)`,
+				params: []any{0, 1, 2},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Any",
+			sc:   SimpleCondition{ColumnName: "col1", Operator: "IN", Values: []any{1, 2, 3}},
+			d:    postgresAny,
+			wants: wants{
+				query:  `"col1" = ANY(?)`,
+				params: []any{[]any{1, 2, 3}},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Not Any",
+			sc:   SimpleCondition{ColumnName: "col1", Operator: "NOT IN", Values: []any{"a", "b"}},
+			d:    postgresAny,
+			wants: wants{
+				query:  `"col1" != ALL(?)`,
+				params: []any{[]any{"a", "b"}},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Empty In",
+			sc:   SimpleCondition{ColumnName: "col1", Operator: "IN", Values: []any{}},
+			d:    dialect.Postgres,
+			wants: wants{
+				query: "1 = 0",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Empty Not In",
+			sc:   SimpleCondition{ColumnName: "col1", Operator: "NOT IN"},
+			d:    postgresAny,
+			wants: wants{
+				query: "1 = 1",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Any Unsupported",
+			sc:        SimpleCondition{ColumnName: "col1", Operator: "IN", Values: []any{1}},
+			d:         dialect.WithInListMode(dialect.MySQL, dialect.InListAny),
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Any w/ ColumnValue",
+			sc:        SimpleCondition{ColumnName: "col1", Operator: "IN", Values: []any{ColumnValue{ColumnName: "col2"}}},
+			d:         postgresAny,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Empty w/ Bad Column",
+			sc:        SimpleCondition{ColumnName: ".col1", Operator: "IN"},
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.sc.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestSimpleCondition_Parameterize_DistinctFrom(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		sc        SimpleCondition
+		d         dialect.Dialect
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Is Distinct From",
+			sc:   SimpleCondition{ColumnName: "col1", Operator: "IS DISTINCT FROM", Values: []any{nil}},
+			d:    dialect.Postgres,
+			wants: wants{
+				query:  `"col1" IS DISTINCT FROM ?`,
+				params: []any{nil},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Is Not Distinct From w/ ColumnValue",
+			sc:   SimpleCondition{ColumnName: "t1.col1", Operator: "IS NOT DISTINCT FROM", Values: []any{ColumnValue{ColumnName: "t2.col1"}}},
+			d:    dialect.SQLite,
+			wants: wants{
+				query:  `"t1"."col1" IS NOT DISTINCT FROM "t2"."col1"`,
+				params: []any{},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Is Distinct From MySQL",
+			sc:   SimpleCondition{ColumnName: "col1", Operator: "IS DISTINCT FROM", Values: []any{42}},
+			d:    dialect.MySQL,
+			wants: wants{
+				query:  "NOT (`col1` <=> ?)",
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Is Not Distinct From MySQL",
+			sc:   SimpleCondition{ColumnName: "col1", Operator: "IS NOT DISTINCT FROM", Values: []any{42}},
+			d:    dialect.MySQL,
+			wants: wants{
+				query:  "`col1` <=> ?",
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.sc.Parameterize(tt.d)
 			tt.assertion(t, err)
 			assert.Equal(t, tt.wants.query, gotQuery)
 			assert.Equal(t, tt.wants.params, gotParams)
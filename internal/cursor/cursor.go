@@ -0,0 +1,95 @@
+package incursor
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+)
+
+// MinKeySize is the minimum number of bytes that a key used to sign cursors must have
+const MinKeySize = 32
+
+var (
+	// ErrInvalidCursor is returned when a cursor is malformed, or was not created using the same key
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrShortKey is returned when the key used to sign cursors has fewer than MinKeySize bytes
+	ErrShortKey = fmt.Errorf("cursor key must be at least %d bytes long", MinKeySize)
+)
+
+func init() {
+	// Basic types are already registered with gob, but time.Time is commonly sorted by as well
+	gob.Register(time.Time{})
+}
+
+// Encode creates an opaque cursor that holds `values`, which is signed using `key` so that any changes to it can be detected.
+// Each value must either be NULL or a type that is registered with `gob.Register`. NULL values, which includes nil pointers,
+// are decoded as nil.
+func Encode(key []byte, values []any) (string, error) {
+	if len(key) < MinKeySize {
+		return "", ErrShortKey
+	}
+
+	// gob is unable to encode a nil pointer held by an interface, so every NULL value is stored as a nil interface instead
+	values = slices.Clone(values)
+	for i, value := range values {
+		if isNullValue(value) {
+			values[i] = nil
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(values); err != nil {
+		return "", fmt.Errorf("failed to encode cursor values: %w", err)
+	}
+
+	payload := buf.Bytes()
+	token := append(sign(key, payload), payload...)
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Decode returns the values held by a cursor created with `Encode`. If the cursor was not created using the same key,
+// or has been changed since it was created, then `ErrInvalidCursor` is returned.
+func Decode(key []byte, cursor string) ([]any, error) {
+	if len(key) < MinKeySize {
+		return nil, ErrShortKey
+	}
+
+	token, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(token) < sha256.Size {
+		return nil, ErrInvalidCursor
+	}
+
+	signature, payload := token[:sha256.Size], token[sha256.Size:]
+	if !hmac.Equal(signature, sign(key, payload)) {
+		return nil, ErrInvalidCursor
+	}
+
+	var values []any
+	if err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&values); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	return values, nil
+}
+
+// isNullValue checks to see if the provided value is nil, or is a nil pointer
+func isNullValue(value any) bool {
+	if value == nil {
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+// sign returns the HMAC-SHA256 of `payload` using `key`
+func sign(key, payload []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
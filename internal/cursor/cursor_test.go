@@ -0,0 +1,97 @@
+package incursor
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestEncodeDecode(t *testing.T) {
+	values := []any{time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), 42, "abc", nil, 2.5, true}
+
+	cursor, err := Encode(testKey, values)
+	assert.NoError(t, err)
+
+	got, err := Decode(testKey, cursor)
+	assert.NoError(t, err)
+	assert.Equal(t, values, got)
+}
+
+func TestEncodeDecode_NullValues(t *testing.T) {
+	var deletedAt *time.Time
+	id := 42
+
+	cursor, err := Encode(testKey, []any{deletedAt, &id, nil})
+	assert.NoError(t, err)
+
+	got, err := Decode(testKey, cursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{nil, 42, nil}, got)
+}
+
+func TestShortKey(t *testing.T) {
+	for _, key := range [][]byte{nil, {}, testKey[:MinKeySize-1]} {
+		_, err := Encode(key, []any{42})
+		assert.ErrorIs(t, err, ErrShortKey)
+
+		got, err := Decode(key, "abc")
+		assert.ErrorIs(t, err, ErrShortKey)
+		assert.Nil(t, got)
+	}
+}
+
+func TestEncode_Error(t *testing.T) {
+	type unregistered struct{ Value int }
+
+	_, err := Encode(testKey, []any{unregistered{Value: 1}})
+	assert.Error(t, err)
+}
+
+func TestDecode_Error(t *testing.T) {
+	key := testKey
+	cursor, err := Encode(key, []any{42})
+	assert.NoError(t, err)
+
+	token, err := base64.RawURLEncoding.DecodeString(cursor)
+	assert.NoError(t, err)
+	token[len(token)-1] ^= 0xFF
+	tampered := base64.RawURLEncoding.EncodeToString(token)
+
+	tests := []struct {
+		name   string
+		key    []byte
+		cursor string
+	}{
+		{
+			name:   "Different Key",
+			key:    []byte("another key that is 32 bytes ..."),
+			cursor: cursor,
+		},
+		{
+			name:   "Tampered",
+			key:    key,
+			cursor: tampered,
+		},
+		{
+			name:   "Not Base64",
+			key:    key,
+			cursor: "not a cursor!",
+		},
+		{
+			name:   "Too Short",
+			key:    key,
+			cursor: "abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.key, tt.cursor)
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+			assert.Nil(t, got)
+		})
+	}
+}
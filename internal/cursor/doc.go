@@ -0,0 +1,2 @@
+// package incursor implements the encoding and decoding of the cursors used for keyset pagination
+package incursor
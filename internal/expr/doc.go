@@ -0,0 +1,2 @@
+// package inexpr holds the core implementation of expressions that can be used within queries
+package inexpr
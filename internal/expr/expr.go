@@ -0,0 +1,324 @@
+package inexpr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+	"github.com/williabk198/jagsqlb/internal/utilities/parsers"
+)
+
+var (
+	columnParser = parsers.NewColumnParser()
+)
+
+// Column is a reference to a table column used as an expression
+type Column struct {
+	Name string
+}
+
+func (c Column) Parameterize(d dialect.Dialect) (string, []any, error) {
+	column, err := columnParser.Parse(c.Name)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parse column in expression: %w", err)
+	}
+
+	return column.Render(d), nil, nil
+}
+
+// Plus returns an expression that adds `value` to the column
+func (c Column) Plus(value any) Arithmetic {
+	return newArithmetic(c, "+", value)
+}
+
+// Minus returns an expression that subtracts `value` from the column
+func (c Column) Minus(value any) Arithmetic {
+	return newArithmetic(c, "-", value)
+}
+
+// Times returns an expression that multiplies the column by `value`
+func (c Column) Times(value any) Arithmetic {
+	return newArithmetic(c, "*", value)
+}
+
+// Div returns an expression that divides the column by `value`
+func (c Column) Div(value any) Arithmetic {
+	return newArithmetic(c, "/", value)
+}
+
+// Value is a value that is bound as a query parameter when used as an expression
+type Value struct {
+	Value any
+}
+
+func (v Value) Parameterize(dialect.Dialect) (string, []any, error) {
+	return "?", []any{v.Value}, nil
+}
+
+// Function represents a call to an SQL function such as "SUM" or "COALESCE"
+type Function struct {
+	Name     string
+	Args     []any
+	Distinct bool // When true, "DISTINCT" will precede the arguments of the function
+}
+
+// As gives the function an alias so that it can be used as a column in a "SELECT" statement
+func (f Function) As(alias string) intypes.SelectColumn {
+	return intypes.SelectColumn{
+		Alias:      alias,
+		Expression: f,
+	}
+}
+
+// Over turns the function into a window function that is evaluated over the provided window
+func (f Function) Over(window Window) WindowFunction {
+	return WindowFunction{
+		Function: f,
+		Window:   window,
+	}
+}
+
+func (f Function) Parameterize(d dialect.Dialect) (string, []any, error) {
+	sb := new(strings.Builder)
+	sb.WriteString(f.Name)
+	sb.WriteRune('(')
+	if f.Distinct {
+		sb.WriteString("DISTINCT ")
+	}
+
+	var params []any
+	for i, arg := range f.Args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		argStr, argParams, err := ToExpression(arg).Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parameterize argument %d of %s: %w", i, f.Name, err)
+		}
+		sb.WriteString(argStr)
+		params = append(params, argParams...)
+	}
+	sb.WriteRune(')')
+
+	return sb.String(), params, nil
+}
+
+// ToExpression converts the provided value into an expression. Strings are treated as column references,
+// expressions are returned as is, and any other value is bound as a query parameter.
+func ToExpression(value any) intypes.Expression {
+	switch v := value.(type) {
+	case string:
+		return Column{Name: v}
+	case intypes.Ordering:
+		return Invalid{Err: intypes.ErrMisplacedOrdering}
+	case intypes.Expression:
+		return v
+	default:
+		return Value{Value: v}
+	}
+}
+
+// Subquery is a query that is embedded, within parentheses, inside of another query
+type Subquery struct {
+	Builder intypes.Builder
+}
+
+// Parameterize builds the subquery with "?" in place of its parameters so that they are numbered along with the rest of
+// the query it is embedded in. The subquery must be built with the same dialect as that query.
+func (s Subquery) Parameterize(d dialect.Dialect) (string, []any, error) {
+	query, params, err := intypes.BuildNested(d, s.Builder)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build subquery: %w", err)
+	}
+
+	return "(" + strings.TrimSuffix(query, ";") + ")", params, nil
+}
+
+// Excluded is a reference to a column of the row that was proposed for insertion, but conflicted with an existing row
+type Excluded struct {
+	Column string
+}
+
+func (e Excluded) Parameterize(d dialect.Dialect) (string, []any, error) {
+	column, err := columnParser.Parse(e.Column)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parse excluded column: %w", err)
+	}
+	if column.Table != nil {
+		return "", nil, fmt.Errorf("excluded column %q cannot reference a table", e.Column)
+	}
+
+	if d.Supports(dialect.ClauseOnConflict) {
+		return "EXCLUDED." + column.Render(d), nil, nil
+	}
+	if d.Supports(dialect.ClauseOnDuplicateKey) {
+		return "VALUES(" + column.Render(d) + ")", nil, nil
+	}
+	return "", nil, intypes.NewUnsupportedClauseError(d, dialect.ClauseOnConflict)
+}
+
+// Arithmetic is an arithmetic operation between two expressions, such as `"stock" - ?`
+type Arithmetic struct {
+	Left     intypes.Expression
+	Operator string
+	Right    intypes.Expression
+}
+
+// newArithmetic creates an Arithmetic expression. If `right` isn't an expression, then it is bound as a query parameter.
+func newArithmetic(left intypes.Expression, operator string, right any) Arithmetic {
+	rightExpr, ok := right.(intypes.Expression)
+	if !ok {
+		rightExpr = Value{Value: right}
+	} else if _, ok := right.(intypes.Ordering); ok {
+		rightExpr = Invalid{Err: intypes.ErrMisplacedOrdering}
+	}
+	return Arithmetic{
+		Left:     left,
+		Operator: operator,
+		Right:    rightExpr,
+	}
+}
+
+// Plus returns an expression that adds `value` to the result of the operation
+func (a Arithmetic) Plus(value any) Arithmetic {
+	return newArithmetic(a, "+", value)
+}
+
+// Minus returns an expression that subtracts `value` from the result of the operation
+func (a Arithmetic) Minus(value any) Arithmetic {
+	return newArithmetic(a, "-", value)
+}
+
+// Times returns an expression that multiplies the result of the operation by `value`
+func (a Arithmetic) Times(value any) Arithmetic {
+	return newArithmetic(a, "*", value)
+}
+
+// Div returns an expression that divides the result of the operation by `value`
+func (a Arithmetic) Div(value any) Arithmetic {
+	return newArithmetic(a, "/", value)
+}
+
+// As gives the operation an alias so that it can be used as a column in a "SELECT" statement
+func (a Arithmetic) As(alias string) intypes.SelectColumn {
+	return intypes.SelectColumn{
+		Alias:      alias,
+		Expression: a,
+	}
+}
+
+func (a Arithmetic) Parameterize(d dialect.Dialect) (string, []any, error) {
+	left, leftParams, err := a.renderOperand(d, a.Left)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parameterize left operand of %q: %w", a.Operator, err)
+	}
+	right, rightParams, err := a.renderOperand(d, a.Right)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parameterize right operand of %q: %w", a.Operator, err)
+	}
+
+	return left + " " + a.Operator + " " + right, append(leftParams, rightParams...), nil
+}
+
+// renderOperand renders one side of the operation. Nested operations are wrapped in parentheses so that they are
+// evaluated in the order that they were written.
+func (a Arithmetic) renderOperand(d dialect.Dialect, operand intypes.Expression) (string, []any, error) {
+	str, params, err := operand.Parameterize(d)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, ok := operand.(Arithmetic); ok {
+		str = "(" + str + ")"
+	}
+	return str, params, nil
+}
+
+// Keyword is an SQL keyword, such as "DEFAULT" or "CURRENT_TIMESTAMP", that is rendered as is
+type Keyword struct {
+	Keyword string
+}
+
+func (k Keyword) Parameterize(dialect.Dialect) (string, []any, error) {
+	return k.Keyword, nil, nil
+}
+
+// CaseWhen is a single "WHEN ... THEN ..." branch of a Case expression
+type CaseWhen struct {
+	Condition intypes.Expression
+	Result    intypes.Expression
+}
+
+// Case is a "CASE" expression that evaluates to the result of the first branch whose condition is true.
+// If none of the conditions are true, then it evaluates to its "ELSE" result, or NULL if there isn't one.
+type Case struct {
+	Whens   []CaseWhen
+	Default intypes.Expression
+}
+
+// When adds a branch that evaluates to `result` when `condition` is true. If `result` is a string,
+// then it is treated as a column reference. Any other value, that isn't an expression, is bound as a query parameter.
+func (c Case) When(condition intypes.Expression, result any) Case {
+	c.Whens = append(append([]CaseWhen(nil), c.Whens...), CaseWhen{Condition: condition, Result: ToExpression(result)})
+	return c
+}
+
+// Else sets what the expression evaluates to when none of its conditions are true.
+// It follows the same rules as the result of When.
+func (c Case) Else(result any) Case {
+	c.Default = ToExpression(result)
+	return c
+}
+
+// As gives the expression an alias so that it can be used as a column in a "SELECT" statement
+func (c Case) As(alias string) intypes.SelectColumn {
+	return intypes.SelectColumn{
+		Alias:      alias,
+		Expression: c,
+	}
+}
+
+func (c Case) Parameterize(d dialect.Dialect) (string, []any, error) {
+	if len(c.Whens) == 0 {
+		return "", nil, fmt.Errorf("case expression requires at least one WHEN branch")
+	}
+
+	sb := new(strings.Builder)
+	sb.WriteString("CASE")
+	var params []any
+	for i, when := range c.Whens {
+		condStr, condParams, err := when.Condition.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parameterize condition of WHEN branch %d: %w", i, err)
+		}
+		resultStr, resultParams, err := when.Result.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parameterize result of WHEN branch %d: %w", i, err)
+		}
+		sb.WriteString(" WHEN " + condStr + " THEN " + resultStr)
+		params = append(append(params, condParams...), resultParams...)
+	}
+
+	if c.Default != nil {
+		defaultStr, defaultParams, err := c.Default.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parameterize ELSE result: %w", err)
+		}
+		sb.WriteString(" ELSE " + defaultStr)
+		params = append(params, defaultParams...)
+	}
+	sb.WriteString(" END")
+
+	return sb.String(), params, nil
+}
+
+// Invalid is used in place of an expression that could not be created.
+// This defers the error until the expression is parameterized when the query is built.
+type Invalid struct {
+	Err error
+}
+
+func (i Invalid) Parameterize(dialect.Dialect) (string, []any, error) {
+	return "", nil, i.Err
+}
@@ -0,0 +1,460 @@
+package inexpr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+func TestColumn_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		c         Column
+		d         dialect.Dialect
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			c:    Column{Name: "t1.col1"},
+			d:    dialect.Postgres,
+			wants: wants{
+				query: `"t1"."col1"`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Wildcard",
+			c:    Column{Name: "*"},
+			d:    dialect.Postgres,
+			wants: wants{
+				query: `*`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; MySQL",
+			c:    Column{Name: "t1.col1"},
+			d:    dialect.MySQL,
+			wants: wants{
+				query: "`t1`.`col1`",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Bad Column",
+			c:         Column{Name: ".col1"},
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.c.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestArithmetic_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		a         Arithmetic
+		d         dialect.Dialect
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Value",
+			a:    Column{Name: "stock"}.Minus(1),
+			d:    dialect.Postgres,
+			wants: wants{
+				query:  `"stock" - ?`,
+				params: []any{1},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; String Value",
+			a:    Column{Name: "t1.name"}.Plus("suffix"),
+			d:    dialect.MySQL,
+			wants: wants{
+				query:  "`t1`.`name` + ?",
+				params: []any{"suffix"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Nested",
+			a:    Column{Name: "price"}.Times(Value{Value: 2}).Div(Column{Name: "qty"}.Plus(1)),
+			d:    dialect.Postgres,
+			wants: wants{
+				query:  `("price" * ?) / ("qty" + ?)`,
+				params: []any{2, 1},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Left Operand",
+			a:         Column{Name: ".price"}.Times(2),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Right Operand",
+			a:         Column{Name: "price"}.Minus(Column{Name: ".discount"}),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.a.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestArithmetic_As(t *testing.T) {
+	a := Column{Name: "price"}.Times(2)
+	assert.Equal(t, intypes.SelectColumn{Alias: "doubled", Expression: a}, a.As("doubled"))
+}
+
+func TestKeyword_Parameterize(t *testing.T) {
+	gotQuery, gotParams, err := Keyword{Keyword: "DEFAULT"}.Parameterize(dialect.Postgres)
+	assert.NoError(t, err)
+	assert.Equal(t, "DEFAULT", gotQuery)
+	assert.Nil(t, gotParams)
+}
+
+func TestValue_Parameterize(t *testing.T) {
+	gotQuery, gotParams, err := Value{Value: "test"}.Parameterize(dialect.Postgres)
+	assert.NoError(t, err)
+	assert.Equal(t, "?", gotQuery)
+	assert.Equal(t, []any{"test"}, gotParams)
+}
+
+func TestFunction_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		f         Function
+		d         dialect.Dialect
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Wildcard",
+			f:    Function{Name: "COUNT", Args: []any{"*"}},
+			d:    dialect.Postgres,
+			wants: wants{
+				query: `COUNT(*)`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Distinct",
+			f:    Function{Name: "COUNT", Args: []any{"o.customer_id"}, Distinct: true},
+			d:    dialect.Postgres,
+			wants: wants{
+				query: `COUNT(DISTINCT "o"."customer_id")`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Bound and Nested Arguments",
+			f: Function{
+				Name: "COALESCE",
+				Args: []any{
+					Function{Name: "LOWER", Args: []any{"name"}},
+					Value{Value: "unknown"},
+					42,
+				},
+			},
+			d: dialect.SQLServer,
+			wants: wants{
+				query:  `COALESCE(LOWER([name]), ?, ?)`,
+				params: []any{"unknown", 42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Bad Argument",
+			f:         Function{Name: "SUM", Args: []any{".price"}},
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.f.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestFunction_As(t *testing.T) {
+	f := Function{Name: "SUM", Args: []any{"price"}}
+	assert.Equal(t, intypes.SelectColumn{Alias: "total", Expression: f}, f.As("total"))
+}
+
+func TestToExpression(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  intypes.Expression
+	}{
+		{
+			name:  "String",
+			value: "col1",
+			want:  Column{Name: "col1"},
+		},
+		{
+			name:  "Expression",
+			value: Function{Name: "SUM", Args: []any{"col1"}},
+			want:  Function{Name: "SUM", Args: []any{"col1"}},
+		},
+		{
+			name:  "Other Value",
+			value: 42,
+			want:  Value{Value: 42},
+		},
+		{
+			name:  "Ordering",
+			value: testOrdering{str: `"col1" ASC`},
+			want:  Invalid{Err: intypes.ErrMisplacedOrdering},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ToExpression(tt.value))
+		})
+	}
+}
+
+// testBuilder is a minimal query builder used to test the embedding of subqueries
+type testBuilder struct {
+	query  string
+	params []any
+	err    error
+}
+
+func (tb testBuilder) Build() (string, []any, error) {
+	return tb.query, tb.params, tb.err
+}
+
+// testRawBuilder is a minimal query builder that can also build its query without finalizing it
+type testRawBuilder struct {
+	testBuilder
+	rawQuery string
+	dialect  dialect.Dialect
+}
+
+func (trb testRawBuilder) BuildRaw() (string, []any, error) {
+	return trb.rawQuery, trb.params, trb.err
+}
+
+func (trb testRawBuilder) Dialect() dialect.Dialect {
+	if trb.dialect == nil {
+		return dialect.Postgres
+	}
+	return trb.dialect
+}
+
+func TestSubquery_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		s         Subquery
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Raw Builder",
+			s: Subquery{
+				Builder: testRawBuilder{
+					testBuilder: testBuilder{query: `SELECT "id" FROM "t1" WHERE "col1" = $1;`, params: []any{42}},
+					rawQuery:    `SELECT "id" FROM "t1" WHERE "col1" = ?;`,
+				},
+			},
+			wants: wants{
+				query:  `(SELECT "id" FROM "t1" WHERE "col1" = ?)`,
+				params: []any{42},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Finalized Builder",
+			s: Subquery{
+				Builder: testBuilder{query: `SELECT "id" FROM "t1" WHERE "col1" = $1;`, params: []any{42}},
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Different Dialect",
+			s: Subquery{
+				Builder: testRawBuilder{rawQuery: "SELECT `id` FROM `t1`;", dialect: dialect.MySQL},
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Build Failure",
+			s: Subquery{
+				Builder: testRawBuilder{testBuilder: testBuilder{err: assert.AnError}},
+			},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.s.Parameterize(dialect.Postgres)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestExcluded_Parameterize(t *testing.T) {
+	tests := []struct {
+		name      string
+		e         Excluded
+		d         dialect.Dialect
+		want      string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "Success; PostgreSQL",
+			e:         Excluded{Column: "qty"},
+			d:         dialect.Postgres,
+			want:      `EXCLUDED."qty"`,
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Success; SQLite",
+			e:         Excluded{Column: "qty"},
+			d:         dialect.SQLite,
+			want:      `EXCLUDED."qty"`,
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Success; MySQL",
+			e:         Excluded{Column: "qty"},
+			d:         dialect.MySQL,
+			want:      "VALUES(`qty`)",
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; SQL Server",
+			e:         Excluded{Column: "qty"},
+			d:         dialect.SQLServer,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Table Reference",
+			e:         Excluded{Column: "t1.qty"},
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Column",
+			e:         Excluded{Column: ""},
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotParams, err := tt.e.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Nil(t, gotParams)
+		})
+	}
+}
+
+func TestCase_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		c         Case
+		d         dialect.Dialect
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			c:    Case{}.When(Keyword{Keyword: `"status" = 'open'`}, 1).When(Keyword{Keyword: `"status" = 'held'`}, 2).Else(3),
+			d:    dialect.Postgres,
+			wants: wants{
+				query:  `CASE WHEN "status" = 'open' THEN ? WHEN "status" = 'held' THEN ? ELSE ? END`,
+				params: []any{1, 2, 3},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Column Results w/o Else",
+			c:    Case{}.When(Keyword{Keyword: "TRUE"}, "t1.col1"),
+			d:    dialect.MySQL,
+			wants: wants{
+				query: "CASE WHEN TRUE THEN `t1`.`col1` END",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; No Branches",
+			c:         Case{}.Else(1),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Result",
+			c:         Case{}.When(Keyword{Keyword: "TRUE"}, ".col1"),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.c.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestCase_As(t *testing.T) {
+	c := Case{}.When(Keyword{Keyword: "TRUE"}, 1)
+	assert.Equal(t, intypes.SelectColumn{Alias: "priority", Expression: c}, c.As("priority"))
+}
@@ -0,0 +1,162 @@
+package inexpr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+// FrameBound is the start or end of a window frame
+type FrameBound struct {
+	Kind   string // One of "UNBOUNDED PRECEDING", "PRECEDING", "CURRENT ROW", "FOLLOWING" or "UNBOUNDED FOLLOWING"
+	Offset uint   // The number of rows, or values, from the current row. Only used by "PRECEDING" and "FOLLOWING".
+}
+
+func (fb FrameBound) render() (string, error) {
+	switch fb.Kind {
+	case "UNBOUNDED PRECEDING", "CURRENT ROW", "UNBOUNDED FOLLOWING":
+		return fb.Kind, nil
+	case "PRECEDING", "FOLLOWING":
+		return fmt.Sprintf("%d %s", fb.Offset, fb.Kind), nil
+	default:
+		return "", fmt.Errorf("invalid window frame bound %q", fb.Kind)
+	}
+}
+
+// Frame limits the rows of a window partition that a window function operates on
+type Frame struct {
+	Unit  string // Either "ROWS" or "RANGE"
+	Start FrameBound
+	End   FrameBound
+}
+
+func (f Frame) render() (string, error) {
+	start, err := f.Start.render()
+	if err != nil {
+		return "", fmt.Errorf("failed to render start of window frame: %w", err)
+	}
+	end, err := f.End.render()
+	if err != nil {
+		return "", fmt.Errorf("failed to render end of window frame: %w", err)
+	}
+
+	return fmt.Sprintf("%s BETWEEN %s AND %s", f.Unit, start, end), nil
+}
+
+// Window defines the set of rows that a window function operates on
+type Window struct {
+	Name        string // The name of a window defined in the "WINDOW" clause that this window refers to or builds upon
+	PartitionBy []any
+	OrderBy     []intypes.Ordering
+	Frame       *Frame
+}
+
+// Partition returns a copy of the window that divides its rows into partitions by the provided columns or expressions
+func (w Window) Partition(column any, moreColumns ...any) Window {
+	w.PartitionBy = append(append([]any(nil), w.PartitionBy...), append([]any{column}, moreColumns...)...)
+	return w
+}
+
+// Order returns a copy of the window that sorts the rows of each partition by the provided orderings
+func (w Window) Order(ordering intypes.Ordering, moreOrderings ...intypes.Ordering) Window {
+	w.OrderBy = append(append([]intypes.Ordering(nil), w.OrderBy...), append([]intypes.Ordering{ordering}, moreOrderings...)...)
+	return w
+}
+
+// Rows returns a copy of the window whose frame is the rows between `start` and `end`
+func (w Window) Rows(start, end FrameBound) Window {
+	w.Frame = &Frame{Unit: "ROWS", Start: start, End: end}
+	return w
+}
+
+// Range returns a copy of the window whose frame is the rows with values between `start` and `end`
+func (w Window) Range(start, end FrameBound) Window {
+	w.Frame = &Frame{Unit: "RANGE", Start: start, End: end}
+	return w
+}
+
+// Parameterize returns the definition of the window as it would appear within parentheses
+func (w Window) Parameterize(d dialect.Dialect) (string, []any, error) {
+	var clauses []string
+	var params []any
+
+	if w.Name != "" {
+		clauses = append(clauses, d.QuoteIdentifier(w.Name))
+	}
+
+	if len(w.PartitionBy) > 0 {
+		partitions := make([]string, len(w.PartitionBy))
+		for i, column := range w.PartitionBy {
+			partition, partitionParams, err := ToExpression(column).Parameterize(d)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to parameterize PARTITION BY expression %d: %w", i, err)
+			}
+			partitions[i] = partition
+			params = append(params, partitionParams...)
+		}
+		clauses = append(clauses, "PARTITION BY "+strings.Join(partitions, ", "))
+	}
+
+	if len(w.OrderBy) > 0 {
+		orderings := make([]string, len(w.OrderBy))
+		for i, ordering := range w.OrderBy {
+			orderingStr, orderingParams, err := ordering.Parameterize(d)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to parameterize window ordering %d: %w", i, err)
+			}
+			orderings[i] = orderingStr
+			params = append(params, orderingParams...)
+		}
+		clauses = append(clauses, "ORDER BY "+strings.Join(orderings, ", "))
+	}
+
+	if w.Frame != nil {
+		frame, err := w.Frame.render()
+		if err != nil {
+			return "", nil, err
+		}
+		clauses = append(clauses, frame)
+	}
+
+	return strings.Join(clauses, " "), params, nil
+}
+
+// isReference reports whether the window only refers to a named window without adding to its definition
+func (w Window) isReference() bool {
+	return w.Name != "" && len(w.PartitionBy) == 0 && len(w.OrderBy) == 0 && w.Frame == nil
+}
+
+// WindowFunction is a function that is evaluated over a window of rows related to the current row
+type WindowFunction struct {
+	Function Function
+	Window   Window
+}
+
+// As gives the window function an alias so that it can be used as a column in a "SELECT" statement
+func (wf WindowFunction) As(alias string) intypes.SelectColumn {
+	return intypes.SelectColumn{
+		Alias:      alias,
+		Expression: wf,
+	}
+}
+
+func (wf WindowFunction) Parameterize(d dialect.Dialect) (string, []any, error) {
+	function, params, err := wf.Function.Parameterize(d)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// A reference to a named window doesn't need to be wrapped in parentheses
+	if wf.Window.isReference() {
+		return fmt.Sprintf("%s OVER %s", function, d.QuoteIdentifier(wf.Window.Name)), params, nil
+	}
+
+	window, windowParams, err := wf.Window.Parameterize(d)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parameterize window of %s: %w", wf.Function.Name, err)
+	}
+
+	return fmt.Sprintf("%s OVER (%s)", function, window), append(params, windowParams...), nil
+}
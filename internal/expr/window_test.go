@@ -0,0 +1,184 @@
+package inexpr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+func TestWindow_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		w         Window
+		d         dialect.Dialect
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "Success; Empty",
+			w:         Window{},
+			d:         dialect.Postgres,
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Partition and Order",
+			w:    Window{}.Partition("t1.col1", Function{Name: "LOWER", Args: []any{"col2"}}).Order(testOrdering{str: `"col3" DESC`}),
+			d:    dialect.Postgres,
+			wants: wants{
+				query: `PARTITION BY "t1"."col1", LOWER("col2") ORDER BY "col3" DESC`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Named Window w/ Rows Frame",
+			w:    Window{Name: "w"}.Rows(FrameBound{Kind: "PRECEDING", Offset: 3}, FrameBound{Kind: "CURRENT ROW"}),
+			d:    dialect.MySQL,
+			wants: wants{
+				query: "`w` ROWS BETWEEN 3 PRECEDING AND CURRENT ROW",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Range Frame w/ Params",
+			w: Window{}.Partition(Value{Value: "x"}).
+				Order(testOrdering{str: "?", params: []any{1}}).
+				Range(FrameBound{Kind: "UNBOUNDED PRECEDING"}, FrameBound{Kind: "FOLLOWING", Offset: 10}),
+			d: dialect.Postgres,
+			wants: wants{
+				query:  `PARTITION BY ? ORDER BY ? RANGE BETWEEN UNBOUNDED PRECEDING AND 10 FOLLOWING`,
+				params: []any{"x", 1},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Bad Partition",
+			w:         Window{}.Partition(".col1"),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Ordering",
+			w:         Window{}.Order(testOrdering{err: assert.AnError}),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Bad Frame Bound",
+			w:         Window{}.Rows(FrameBound{Kind: "SOMEWHERE"}, FrameBound{Kind: "CURRENT ROW"}),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.w.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestWindow_Partition(t *testing.T) {
+	base := Window{}.Partition("col1")
+
+	// Adding to the same window multiple times should not cause the results to affect each other
+	first := base.Partition("col2")
+	second := base.Partition("col3")
+
+	assert.Equal(t, []any{"col1", "col2"}, first.PartitionBy)
+	assert.Equal(t, []any{"col1", "col3"}, second.PartitionBy)
+}
+
+func TestWindowFunction_Parameterize(t *testing.T) {
+	type wants struct {
+		query  string
+		params []any
+	}
+
+	tests := []struct {
+		name      string
+		wf        WindowFunction
+		d         dialect.Dialect
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Empty Window",
+			wf:   Function{Name: "ROW_NUMBER"}.Over(Window{}),
+			d:    dialect.Postgres,
+			wants: wants{
+				query: `ROW_NUMBER() OVER ()`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Named Window Reference",
+			wf:   Function{Name: "RANK"}.Over(Window{Name: "w"}),
+			d:    dialect.SQLServer,
+			wants: wants{
+				query: `RANK() OVER [w]`,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Params",
+			wf: Function{Name: "LAG", Args: []any{"price", 1, 0}}.Over(
+				Window{}.Partition("sku").Order(testOrdering{str: `"created_at" ASC`}),
+			),
+			d: dialect.Postgres,
+			wants: wants{
+				query:  `LAG("price", ?, ?) OVER (PARTITION BY "sku" ORDER BY "created_at" ASC)`,
+				params: []any{1, 0},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Function",
+			wf:        Function{Name: "SUM", Args: []any{".col1"}}.Over(Window{}),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Window",
+			wf:        Function{Name: "SUM", Args: []any{"col1"}}.Over(Window{}.Partition(".col2")),
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, gotParams, err := tt.wf.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.query, gotQuery)
+			assert.Equal(t, tt.wants.params, gotParams)
+		})
+	}
+}
+
+func TestWindowFunction_As(t *testing.T) {
+	wf := Function{Name: "ROW_NUMBER"}.Over(Window{})
+	assert.Equal(t, intypes.SelectColumn{Alias: "n", Expression: wf}, wf.As("n"))
+}
+
+// testOrdering is a minimal ordering used in place of `types.ColumnOrdering`
+type testOrdering struct {
+	str    string
+	params []any
+	err    error
+}
+
+func (to testOrdering) Parameterize(dialect.Dialect) (string, []any, error) {
+	return to.str, to.params, to.err
+}
+
+func (to testOrdering) ParameterizeColumn(dialect.Dialect) (string, []any, error) {
+	return to.str, to.params, to.err
+}
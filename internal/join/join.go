@@ -1,7 +1,25 @@
 package injoin
 
+import "strings"
+
 type Type string
 
+// HasRelation checks to see if the join type needs to be followed by an "ON" or "USING" relation.
+// CROSS and NATURAL joins don't have one.
+func (t Type) HasRelation() bool {
+	return t != "CROSS JOIN" && !t.IsNatural()
+}
+
+// IsNatural checks to see if the join type is a NATURAL join, which joins the tables using every column they have in common
+func (t Type) IsNatural() bool {
+	return strings.HasPrefix(string(t), "NATURAL ")
+}
+
+// IsFull checks to see if the join type is a FULL OUTER join
+func (t Type) IsFull() bool {
+	return strings.Contains(string(t), "FULL ")
+}
+
 type Relation struct {
 	Keyword  string
 	Relation any
@@ -0,0 +1,2 @@
+// package inscan holds the implementation of reading the rows of a result set into Go values
+package inscan
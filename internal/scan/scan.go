@@ -0,0 +1,144 @@
+package inscan
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+
+	"github.com/williabk198/jagsqlb/internal/utilities/parsers"
+)
+
+// Rows is the subset of `*sql.Rows` that is needed to read a result set
+type Rows interface {
+	Close() error
+	Columns() ([]string, error)
+	Err() error
+	Next() bool
+	Scan(dest ...any) error
+}
+
+// All reads every row of `rows` into a value of type T, then closes `rows`
+func All[T any](rows Rows) ([]T, error) {
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the columns of the result set: %w", err)
+	}
+
+	destinations, err := newDestinations[T](columns)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []T{}
+	for rows.Next() {
+		var result T
+		if err := destinations.scan(rows, &result); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over the result set: %w", err)
+	}
+
+	return results, nil
+}
+
+// One reads the first row of `rows` into a value of type T, then closes `rows`.
+// If there are no rows, then `sql.ErrNoRows` is returned.
+func One[T any](rows Rows) (T, error) {
+	defer rows.Close()
+
+	var result T
+	columns, err := rows.Columns()
+	if err != nil {
+		return result, fmt.Errorf("failed to get the columns of the result set: %w", err)
+	}
+
+	destinations, err := newDestinations[T](columns)
+	if err != nil {
+		return result, err
+	}
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return result, fmt.Errorf("failed to iterate over the result set: %w", err)
+		}
+		return result, sql.ErrNoRows
+	}
+
+	if err := destinations.scan(rows, &result); err != nil {
+		return result, err
+	}
+
+	return result, rows.Close()
+}
+
+// destinations describes where each column of a result set is read into within a value
+type destinations struct {
+	// fields holds the index of the struct field that each column is read into. When it is nil, the value is read
+	// into directly instead.
+	fields [][]int
+}
+
+// newDestinations determines where each of the provided columns are read into within a value of type T. Structs are
+// read into field by field using their `jagsqlb` struct tags, unless they implement `sql.Scanner`. Any other type is
+// read into directly, which requires the result set to have a single column.
+func newDestinations[T any](columns []string) (destinations, error) {
+	valueType := reflect.TypeFor[T]()
+	if valueType.Kind() == reflect.Pointer {
+		valueType = valueType.Elem()
+	}
+
+	if valueType.Kind() != reflect.Struct || reflect.PointerTo(valueType).Implements(reflect.TypeFor[sql.Scanner]()) {
+		if len(columns) != 1 {
+			return destinations{}, fmt.Errorf("cannot read %d columns into %s; only a single column can be read into a non-struct type", len(columns), valueType)
+		}
+		return destinations{}, nil
+	}
+
+	fields, err := parsers.ParseColumnFields(valueType)
+	if err != nil {
+		return destinations{}, fmt.Errorf("failed to parse the fields of %s: %w", valueType, err)
+	}
+
+	d := destinations{fields: make([][]int, len(columns))}
+	for i, column := range columns {
+		index, ok := fields[column]
+		if !ok {
+			return destinations{}, fmt.Errorf("no field of %s is associated with the column %q", valueType, column)
+		}
+		d.fields[i] = index
+	}
+
+	return d, nil
+}
+
+// scan reads the current row of `rows` into the value that `result` points to
+func (d destinations) scan(rows Rows, result any) error {
+	if d.fields == nil {
+		if err := rows.Scan(result); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		return nil
+	}
+
+	value := reflect.ValueOf(result).Elem()
+	if value.Kind() == reflect.Pointer {
+		// Allocate the struct that the pointer will point to
+		value.Set(reflect.New(value.Type().Elem()))
+		value = value.Elem()
+	}
+
+	dest := make([]any, len(d.fields))
+	for i, index := range d.fields {
+		dest[i] = value.FieldByIndex(index).Addr().Interface()
+	}
+
+	if err := rows.Scan(dest...); err != nil {
+		return fmt.Errorf("failed to scan row: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package inscan
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testRows is a minimal result set that assigns its values in a similar manner to `*sql.Rows`
+type testRows struct {
+	columns    []string
+	values     [][]any
+	columnsErr error
+	scanErr    error
+	err        error
+
+	current int
+	closed  bool
+}
+
+func (tr *testRows) Close() error {
+	tr.closed = true
+	return nil
+}
+
+func (tr *testRows) Columns() ([]string, error) {
+	return tr.columns, tr.columnsErr
+}
+
+func (tr *testRows) Err() error {
+	return tr.err
+}
+
+func (tr *testRows) Next() bool {
+	if tr.current >= len(tr.values) {
+		return false
+	}
+	tr.current++
+	return true
+}
+
+func (tr *testRows) Scan(dest ...any) error {
+	if tr.scanErr != nil {
+		return tr.scanErr
+	}
+
+	row := tr.values[tr.current-1]
+	if len(dest) != len(row) {
+		return fmt.Errorf("expected %d destination arguments in Scan, not %d", len(row), len(dest))
+	}
+
+	for i, d := range dest {
+		if scanner, ok := d.(sql.Scanner); ok {
+			if err := scanner.Scan(row[i]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value := reflect.ValueOf(d).Elem()
+		if row[i] == nil {
+			value.Set(reflect.Zero(value.Type()))
+			continue
+		}
+		if value.Kind() == reflect.Pointer {
+			value.Set(reflect.New(value.Type().Elem()))
+			value = value.Elem()
+		}
+		value.Set(reflect.ValueOf(row[i]).Convert(value.Type()))
+	}
+
+	return nil
+}
+
+// upperString is a `sql.Scanner` that converts the value that it reads to upper case
+type upperString string
+
+func (us *upperString) Scan(src any) error {
+	str, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into upperString", src)
+	}
+	*us = upperString(strings.ToUpper(str))
+	return nil
+}
+
+type nameData struct {
+	GivenName  string `jagsqlb:"given_name"`
+	FamilyName string `jagsqlb:"family_name"`
+}
+
+type person struct {
+	ID       int      `jagsqlb:"id;omit"`
+	Name     nameData `jagsqlb:";inline"`
+	Nickname *string  `jagsqlb:"nickname"`
+	Code     upperString
+}
+
+func TestAll(t *testing.T) {
+	nickname := "Tester"
+
+	tests := []struct {
+		name      string
+		rows      *testRows
+		want      []person
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			rows: &testRows{
+				columns: []string{"id", "given_name", "family_name", "nickname", "Code"},
+				values: [][]any{
+					{1, "Testy", "McTesterson", "Tester", "abc"},
+					{2, "Some", "Guy", nil, "xyz"},
+				},
+			},
+			want: []person{
+				{ID: 1, Name: nameData{GivenName: "Testy", FamilyName: "McTesterson"}, Nickname: &nickname, Code: "ABC"},
+				{ID: 2, Name: nameData{GivenName: "Some", FamilyName: "Guy"}, Code: "XYZ"},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Subset of Columns",
+			rows: &testRows{
+				columns: []string{"family_name", "id"},
+				values: [][]any{
+					{"McTesterson", 1},
+				},
+			},
+			want: []person{
+				{ID: 1, Name: nameData{FamilyName: "McTesterson"}},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; No Rows",
+			rows: &testRows{
+				columns: []string{"id"},
+			},
+			want:      []person{},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Unknown Column",
+			rows: &testRows{
+				columns: []string{"id", "age"},
+				values:  [][]any{{1, 42}},
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Columns",
+			rows: &testRows{
+				columnsErr: assert.AnError,
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Scan",
+			rows: &testRows{
+				columns: []string{"id"},
+				values:  [][]any{{1}},
+				scanErr: assert.AnError,
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Iteration",
+			rows: &testRows{
+				columns: []string{"id"},
+				values:  [][]any{{1}},
+				err:     assert.AnError,
+			},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := All[person](tt.rows)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, tt.rows.closed)
+		})
+	}
+}
+
+func TestAll_NonStruct(t *testing.T) {
+	got, err := All[*int](&testRows{
+		columns: []string{"count"},
+		values:  [][]any{{1}, {nil}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, 1, *got[0])
+	assert.Nil(t, got[1])
+
+	scanners, err := All[upperString](&testRows{
+		columns: []string{"code"},
+		values:  [][]any{{"abc"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []upperString{"ABC"}, scanners)
+
+	_, err = All[int](&testRows{
+		columns: []string{"col1", "col2"},
+	})
+	assert.Error(t, err)
+}
+
+func TestOne(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      *testRows
+		want      *person
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; First Row",
+			rows: &testRows{
+				columns: []string{"id", "given_name"},
+				values: [][]any{
+					{1, "Testy"},
+					{2, "Some"},
+				},
+			},
+			want:      &person{ID: 1, Name: nameData{GivenName: "Testy"}},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; No Rows",
+			rows: &testRows{
+				columns: []string{"id"},
+			},
+			assertion: func(tt assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(tt, err, sql.ErrNoRows, i...)
+			},
+		},
+		{
+			name: "Error; Iteration",
+			rows: &testRows{
+				columns: []string{"id"},
+				err:     assert.AnError,
+			},
+			assertion: func(tt assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(tt, err, assert.AnError, i...)
+			},
+		},
+		{
+			name: "Error; Unknown Column",
+			rows: &testRows{
+				columns: []string{"age"},
+				values:  [][]any{{42}},
+			},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := One[*person](tt.rows)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, tt.rows.closed)
+		})
+	}
+}
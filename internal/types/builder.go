@@ -0,0 +1,34 @@
+package intypes
+
+import (
+	"fmt"
+
+	"github.com/williabk198/jagsqlb/dialect"
+)
+
+// RawBuilder is implemented by query builders that can produce their query with "?" in place of each of its parameters.
+// This allows a query to be embedded within another one before any of the placeholders are numbered.
+type RawBuilder interface {
+	BuildRaw() (string, []any, error)
+	// Dialect returns the dialect that the query is rendered with
+	Dialect() dialect.Dialect
+}
+
+// Builder mirrors `builders.Builder` so that internal packages can accept query builders without causing an import cycle
+type Builder interface {
+	Build() (string, []any, error)
+}
+
+// BuildNested builds `b` with "?" in place of each of its parameters so that it can be embedded within a query that is
+// rendered with the dialect `d`. An error is returned if `b` doesn't implement RawBuilder, since its placeholders would
+// already be numbered, or if it renders its query with a different dialect.
+func BuildNested(d dialect.Dialect, b Builder) (string, []any, error) {
+	rb, ok := b.(RawBuilder)
+	if !ok {
+		return "", nil, fmt.Errorf("a query built by %T cannot be embedded within another query", b)
+	}
+	if rb.Dialect().Name() != d.Name() {
+		return "", nil, fmt.Errorf("a query rendered for %s cannot be embedded within a query rendered for %s", rb.Dialect().Name(), d.Name())
+	}
+	return rb.BuildRaw()
+}
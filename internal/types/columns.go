@@ -1,6 +1,10 @@
 package intypes
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/williabk198/jagsqlb/dialect"
+)
 
 type Column struct {
 	Name  string
@@ -8,20 +12,22 @@ type Column struct {
 }
 
 func (c Column) String() string {
-	sb := new(strings.Builder)
+	return c.Render(dialect.Postgres)
+}
 
+// Render returns the column definition, quoting the identifiers using the provided dialect
+func (c Column) Render(d dialect.Dialect) string {
+	sb := new(strings.Builder)
 	if c.Table != nil {
-		// Don't use Table's String method since that can return its alias which is unwanted here.
-		sb.WriteString(c.Table.ReferenceString())
+		// Don't use Table's Render method since that can return its alias which is unwanted here.
+		sb.WriteString(c.Table.RenderReference(d))
 		sb.WriteRune('.')
 	}
 
 	if c.Name == "*" {
 		sb.WriteString(c.Name)
 	} else {
-		sb.WriteRune('"')
-		sb.WriteString(c.Name)
-		sb.WriteRune('"')
+		sb.WriteString(d.QuoteIdentifier(c.Name))
 	}
 
 	return sb.String()
@@ -30,19 +36,39 @@ func (c Column) String() string {
 type SelectColumn struct {
 	Alias string
 	Column
+
+	// Expression is selected in place of Column when it is not nil
+	Expression Expression
 }
 
 func (sc SelectColumn) String() string {
-	result := sc.Column.String()
-	if sc.Alias == "" || sc.Name == "*" {
-		return result
+	result, _, _ := sc.Render(dialect.Postgres)
+	return result
+}
+
+// Render returns the select column definition, quoting the identifiers using the provided dialect,
+// along with the parameters of its expression if one was provided
+func (sc SelectColumn) Render(d dialect.Dialect) (string, []any, error) {
+	var result string
+	var params []any
+	if sc.Expression != nil {
+		var err error
+		result, params, err = sc.Expression.Parameterize(d)
+		if err != nil {
+			return "", nil, err
+		}
+	} else {
+		result = sc.Column.Render(d)
+	}
+
+	if sc.Alias == "" || (sc.Expression == nil && sc.Name == "*") {
+		return result, params, nil
 	}
 
 	sb := new(strings.Builder)
 	sb.WriteString(result)
-	sb.WriteString(` AS "`)
-	sb.WriteString(sc.Alias)
-	sb.WriteRune('"')
+	sb.WriteString(" AS ")
+	sb.WriteString(d.QuoteIdentifier(sc.Alias))
 
-	return sb.String()
+	return sb.String(), params, nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
 )
 
 func TestColumn_String(t *testing.T) {
@@ -138,3 +139,62 @@ func TestSelectorColumn_String(t *testing.T) {
 		})
 	}
 }
+
+// testExpression is a minimal Expression used to test the rendering of select columns
+type testExpression struct{}
+
+func (testExpression) Parameterize(d dialect.Dialect) (string, []any, error) {
+	return "LOWER(" + d.QuoteIdentifier("testCol") + ", ?)", []any{"param"}, nil
+}
+
+func TestSelectColumn_Render(t *testing.T) {
+	tests := []struct {
+		name       string
+		sc         SelectColumn
+		d          dialect.Dialect
+		want       string
+		wantParams []any
+	}{
+		{
+			name: "MySQL",
+			sc: SelectColumn{
+				Alias: "tc",
+				Column: Column{
+					Name:  "testCol",
+					Table: &Table{Name: "testTable", Schema: "testing"},
+				},
+			},
+			d:    dialect.MySQL,
+			want: "`testing`.`testTable`.`testCol` AS `tc`",
+		},
+		{
+			name: "SQL Server w/ Aliased Table",
+			sc: SelectColumn{
+				Column: Column{
+					Name:  "testCol",
+					Table: &Table{Alias: "t", Name: "testTable"},
+				},
+			},
+			d:    dialect.SQLServer,
+			want: "[t].[testCol]",
+		},
+		{
+			name: "Aliased Expression",
+			sc: SelectColumn{
+				Alias:      "lc",
+				Expression: testExpression{},
+			},
+			d:          dialect.MySQL,
+			want:       "LOWER(`testCol`, ?) AS `lc`",
+			wantParams: []any{"param"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotParams, err := tt.sc.Render(tt.d)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package intypes
 import (
 	"fmt"
 	"strings"
+
+	"github.com/williabk198/jagsqlb/dialect"
 )
 
 var (
@@ -16,6 +18,10 @@ func NewInvalidSyntaxError(errString string) error {
 	return fmt.Errorf("invalid syntax error: %s", errString)
 }
 
+func NewUnsupportedClauseError(d dialect.Dialect, clause dialect.Clause) error {
+	return fmt.Errorf("unsupported clause error: %s is not supported by the %s dialect", clause, d.Name())
+}
+
 type ErrorSlice []error
 
 func (es ErrorSlice) Append(err error) {
@@ -37,3 +43,22 @@ func (es ErrorSlice) Error() string {
 
 	return sb.String()
 }
+
+// Unwrap returns each of the errors so that they can be checked with `errors.Is` and `errors.As`
+func (es ErrorSlice) Unwrap() []error {
+	return es
+}
+
+// QueryError is returned when a built query fails to run. It holds the query so that it can be inspected or logged.
+type QueryError struct {
+	Query string
+	Err   error
+}
+
+func (qe *QueryError) Error() string {
+	return fmt.Sprintf("failed to run query %q: %v", qe.Query, qe.Err)
+}
+
+func (qe *QueryError) Unwrap() error {
+	return qe.Err
+}
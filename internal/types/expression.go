@@ -0,0 +1,25 @@
+package intypes
+
+import (
+	"errors"
+
+	"github.com/williabk198/jagsqlb/dialect"
+)
+
+// ErrMisplacedOrdering is returned when an ordering is used where any other expression is expected
+var ErrMisplacedOrdering = errors.New("an ordering can only be used to sort rows, such as in an ORDER BY clause")
+
+// Expression represents a value within a query that is more than a plain column reference (e.g. a function call)
+type Expression interface {
+	// Parameterize returns the expression as a string with "?" in place of each of its bound values, along with the values themselves.
+	// Any identifiers are quoted using the provided dialect.
+	Parameterize(d dialect.Dialect) (string, []any, error)
+}
+
+// Ordering is an expression that sorts rows along with the direction it sorts them in, such as `"created_at" DESC`.
+// Since it is only valid where rows are being sorted, it is rejected wherever any other expression is accepted.
+type Ordering interface {
+	Expression
+	// ParameterizeColumn returns the column, or expression, that is being sorted without the direction of the ordering
+	ParameterizeColumn(d dialect.Dialect) (string, []any, error)
+}
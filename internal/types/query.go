@@ -7,6 +7,7 @@ type QueryMarshaler interface {
 type QueryType string
 
 const (
+	QueryTypeFilter QueryType = "filter"
 	QueryTypeInsert QueryType = "insert"
 	QueryTypeUpdate QueryType = "update"
 )
@@ -3,12 +3,18 @@ package intypes
 import (
 	"fmt"
 	"strings"
+
+	"github.com/williabk198/jagsqlb/dialect"
 )
 
 type Table struct {
 	Alias  string
 	Name   string
 	Schema string
+	// SubQuery is the query whose result set is used as the table, in which case Name and Schema are not used
+	SubQuery Expression
+	// Lateral allows SubQuery to refer to the columns of the tables that precede it
+	Lateral bool
 }
 
 // ReferenceString returns a string that can be used as a reference to the table.
@@ -16,37 +22,70 @@ type Table struct {
 // then the both the schema and the table name will be returned in the format "schema.table".
 // Otherwise, just the Name field is returned.
 func (t Table) ReferenceString() string {
-	refStr := t.Name
+	return t.RenderReference(dialect.Postgres)
+}
+
+// RenderReference is the same as ReferenceString, but quotes the identifiers using the provided dialect
+func (t Table) RenderReference(d dialect.Dialect) string {
 	if t.Alias != "" {
-		refStr = t.Alias
+		return d.QuoteIdentifier(t.Alias)
 	}
-
-	if t.Schema == "" || t.Alias != "" {
-		return fmt.Sprintf("%q", refStr)
+	if t.Schema == "" {
+		return d.QuoteIdentifier(t.Name)
 	}
 
-	return fmt.Sprintf("%q.%q", t.Schema, t.Name)
-
+	return d.QuoteIdentifier(t.Schema) + "." + d.QuoteIdentifier(t.Name)
 }
 
 func (t Table) String() string {
-	sb := new(strings.Builder)
+	return t.Render(dialect.Postgres)
+}
 
+// Render returns the full definition of the table, quoting the identifiers using the provided dialect
+func (t Table) Render(d dialect.Dialect) string {
+	sb := new(strings.Builder)
 	if t.Schema != "" {
-		sb.WriteRune('"')
-		sb.WriteString(t.Schema)
-		sb.WriteString(`".`)
+		sb.WriteString(d.QuoteIdentifier(t.Schema))
+		sb.WriteRune('.')
 	}
-
-	sb.WriteRune('"')
-	sb.WriteString(t.Name)
-	sb.WriteRune('"')
-
+	sb.WriteString(d.QuoteIdentifier(t.Name))
 	if t.Alias != "" {
-		sb.WriteString(` AS "`)
-		sb.WriteString(t.Alias)
-		sb.WriteRune('"')
+		sb.WriteString(" AS ")
+		sb.WriteString(d.QuoteIdentifier(t.Alias))
 	}
 
 	return sb.String()
 }
+
+// Parameterize is the same as Render, but also supports tables whose rows come from a subquery.
+// In which case, the parameters of the subquery are returned as well.
+func (t Table) Parameterize(d dialect.Dialect) (string, []any, error) {
+	if t.SubQuery == nil {
+		if t.Lateral {
+			return "", nil, fmt.Errorf("LATERAL can only be used with a subquery; %s is not one", t.Render(d))
+		}
+		return t.Render(d), nil, nil
+	}
+
+	if t.Alias == "" {
+		return "", nil, fmt.Errorf("a subquery used as a table must have an alias: %w", ErrMissingAliasName)
+	}
+	if t.Lateral && !d.Supports(dialect.ClauseLateral) {
+		return "", nil, NewUnsupportedClauseError(d, dialect.ClauseLateral)
+	}
+
+	query, params, err := t.SubQuery.Parameterize(d)
+	if err != nil {
+		return "", nil, err
+	}
+
+	sb := new(strings.Builder)
+	if t.Lateral {
+		sb.WriteString("LATERAL ")
+	}
+	sb.WriteString(query)
+	sb.WriteString(" AS ")
+	sb.WriteString(d.QuoteIdentifier(t.Alias))
+
+	return sb.String(), params, nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
 )
 
 func TestTable_ReferenceString(t *testing.T) {
@@ -80,3 +81,127 @@ func TestTable_String(t *testing.T) {
 		})
 	}
 }
+
+func TestTable_Render(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Table
+		d    dialect.Dialect
+		want string
+	}{
+		{
+			name: "PostgreSQL",
+			tr: Table{
+				Alias:  "tt",
+				Name:   "testTable",
+				Schema: "testing",
+			},
+			d:    dialect.Postgres,
+			want: `"testing"."testTable" AS "tt"`,
+		},
+		{
+			name: "MySQL",
+			tr: Table{
+				Alias:  "tt",
+				Name:   "testTable",
+				Schema: "testing",
+			},
+			d:    dialect.MySQL,
+			want: "`testing`.`testTable` AS `tt`",
+		},
+		{
+			name: "SQL Server",
+			tr: Table{
+				Name:   "testTable",
+				Schema: "testing",
+			},
+			d:    dialect.SQLServer,
+			want: "[testing].[testTable]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.tr.Render(tt.d))
+		})
+	}
+}
+
+func TestTable_Parameterize(t *testing.T) {
+	tests := []struct {
+		name       string
+		tr         Table
+		d          dialect.Dialect
+		want       string
+		wantParams []any
+		assertion  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Table",
+			tr: Table{
+				Alias: "tt",
+				Name:  "testTable",
+			},
+			d:         dialect.Postgres,
+			want:      `"testTable" AS "tt"`,
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Subquery",
+			tr: Table{
+				Alias:    "tt",
+				SubQuery: testExpression{},
+			},
+			d:          dialect.MySQL,
+			want:       "LOWER(`testCol`, ?) AS `tt`",
+			wantParams: []any{"param"},
+			assertion:  assert.NoError,
+		},
+		{
+			name: "Success; Lateral Subquery",
+			tr: Table{
+				Alias:    "tt",
+				SubQuery: testExpression{},
+				Lateral:  true,
+			},
+			d:          dialect.Postgres,
+			want:       `LATERAL LOWER("testCol", ?) AS "tt"`,
+			wantParams: []any{"param"},
+			assertion:  assert.NoError,
+		},
+		{
+			name: "Error; Subquery Without Alias",
+			tr: Table{
+				SubQuery: testExpression{},
+			},
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Lateral Table",
+			tr: Table{
+				Name:    "testTable",
+				Lateral: true,
+			},
+			d:         dialect.Postgres,
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Lateral Unsupported",
+			tr: Table{
+				Alias:    "tt",
+				SubQuery: testExpression{},
+				Lateral:  true,
+			},
+			d:         dialect.SQLServer,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotParams, err := tt.tr.Parameterize(tt.d)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
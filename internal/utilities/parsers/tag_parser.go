@@ -16,6 +16,19 @@ var (
 // Otherwise, it will look for the `jagsqlb` struct tag which denotes the names of the column in
 // the database and returns the mapping of that column to its corresponding value.
 func ParseColumnTag(queryType intypes.QueryType, input any) (cols []string, vals []any, err error) {
+	cols, _, vals, err = parseColumnTag(queryType, input)
+	return cols, vals, err
+}
+
+// ParseFilterTag works the same way as `ParseColumnTag`, but also returns the operator that each column should be compared
+// to its value with. The operator is provided with the "op" option of the struct tag(e.g. `jagsqlb:"price;op=>="`),
+// and is empty if the option isn't present.
+func ParseFilterTag(input any) (cols []string, ops []string, vals []any, err error) {
+	return parseColumnTag(intypes.QueryTypeFilter, input)
+}
+
+// parseColumnTag implements `ParseColumnTag` and `ParseFilterTag`
+func parseColumnTag(queryType intypes.QueryType, input any) (cols []string, ops []string, vals []any, err error) {
 	// Since we are working with the reflect package, we need to worry about handling panics so that it errors out gracefully,
 	// instead of just crashing out.
 	defer func() {
@@ -27,74 +40,125 @@ func ParseColumnTag(queryType intypes.QueryType, input any) (cols []string, vals
 	inputType := reflect.TypeOf(input)
 	inputValue := reflect.ValueOf(input)
 	if inputType.Kind() != reflect.Struct {
-		return nil, nil, ErrInputTypeNotStruct
+		return nil, nil, nil, ErrInputTypeNotStruct
 	}
 
 	cols = []string{}
+	ops = []string{}
 	vals = []any{}
 	for i := range inputType.NumField() {
 		fieldType := inputType.Field(i)
 		fieldVal := inputValue.Field(i)
 
-		tagVal := fieldType.Tag.Get("jagsqlb")
-		splitVals := strings.Split(tagVal, ";")
-
-		tagData := tagData{
-			columnName: splitVals[0],
-		}
-		for i := 1; i < len(splitVals); i++ {
-			switch splitVals[i] {
-			case "inline":
-				tagData.inline = true
-			case "omit":
-				tagData.omit = true
-			case "omit-insert":
-				tagData.omitInsert = true
-			case "omit-update":
-				tagData.omitUpdate = true
-			}
-		}
+		tagData := parseTagData(fieldType)
 
 		if tagData.omit || queryType == intypes.QueryTypeInsert && tagData.omitInsert || queryType == intypes.QueryTypeUpdate && tagData.omitUpdate {
 			continue
 		}
 
 		fieldData := fieldVal.Interface()
-		if fieldType.Type.Implements(reflect.TypeFor[intypes.QueryMarshaler]()) {
+		isNilPointer := fieldType.Type.Kind() == reflect.Pointer && fieldVal.IsNil()
+		if fieldType.Type.Implements(reflect.TypeFor[intypes.QueryMarshaler]()) && !isNilPointer {
 			// If the current field implements the QueryMarshaler interface, the use that
-			// to build the value for the column
+			// to build the value for the column. A nil pointer can't be marshaled, so it is used as is.
 			qm := fieldVal.Interface().(intypes.QueryMarshaler)
 			fieldData, err = qm.MarshalQuery()
 			if err != nil {
-				return nil, nil, fmt.Errorf(
+				return nil, nil, nil, fmt.Errorf(
 					"failed to marshal struct data for field %q: %w",
 					fieldType.Name, err,
 				)
 			}
 		} else if tagData.inline && fieldType.Type.Kind() == reflect.Struct {
 			// Otherwise, if the property is a struct and has been marked as "inline",
-			// then recursively call ParseColumnTag to get the columns and values of the nested struct
-			c, v, e := ParseColumnTag(queryType, fieldVal.Interface())
+			// then recursively call parseColumnTag to get the columns and values of the nested struct
+			c, o, v, e := parseColumnTag(queryType, fieldVal.Interface())
 			if e != nil {
-				return nil, nil, fmt.Errorf(
+				return nil, nil, nil, fmt.Errorf(
 					"failed to marshal nested struct data for field %q: %w",
 					fieldType.Name, e,
 				)
 			}
 			cols = append(cols, c...)
+			ops = append(ops, o...)
 			vals = append(vals, v...)
 			continue
 		}
 
-		if tagData.columnName != "" {
-			cols = append(cols, tagData.columnName)
-		} else {
-			cols = append(cols, fieldType.Name)
-		}
+		cols = append(cols, tagData.columnName)
+		ops = append(ops, tagData.operator)
 		vals = append(vals, fieldData)
 	}
 
-	return cols, vals, nil
+	return cols, ops, vals, nil
+}
+
+// ParseColumnFields expects a struct type for `structType`. If it isn't a struct, then an error is returned.
+// Otherwise, it will use the `jagsqlb` struct tag, in the same way as `ParseColumnTag`, to find the field that each
+// column should be read into. The index of each field, as used by `reflect.Value.FieldByIndex`, is returned
+// by the name of its column. Since the fields are read into rather than written from, the "omit" options are ignored.
+func ParseColumnFields(structType reflect.Type) (map[string][]int, error) {
+	if structType.Kind() != reflect.Struct {
+		return nil, ErrInputTypeNotStruct
+	}
+
+	fields := make(map[string][]int)
+	for i := range structType.NumField() {
+		fieldType := structType.Field(i)
+		if !fieldType.IsExported() {
+			continue
+		}
+
+		tagData := parseTagData(fieldType)
+		if tagData.inline && fieldType.Type.Kind() == reflect.Struct {
+			nestedFields, err := ParseColumnFields(fieldType.Type)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse fields of nested struct %q: %w", fieldType.Name, err)
+			}
+
+			for column, index := range nestedFields {
+				fields[column] = append([]int{i}, index...)
+			}
+			continue
+		}
+
+		fields[tagData.columnName] = []int{i}
+	}
+
+	return fields, nil
+}
+
+// parseTagData reads the `jagsqlb` struct tag of the provided field. If the tag doesn't define a column name,
+// then the name of the field is used instead.
+func parseTagData(field reflect.StructField) tagData {
+	splitVals := strings.Split(field.Tag.Get("jagsqlb"), ";")
+
+	data := tagData{
+		columnName: splitVals[0],
+	}
+	if data.columnName == "" {
+		data.columnName = field.Name
+	}
+
+	for i := 1; i < len(splitVals); i++ {
+		if operator, ok := strings.CutPrefix(splitVals[i], "op="); ok {
+			data.operator = strings.TrimSpace(operator)
+			continue
+		}
+
+		switch splitVals[i] {
+		case "inline":
+			data.inline = true
+		case "omit":
+			data.omit = true
+		case "omit-insert":
+			data.omitInsert = true
+		case "omit-update":
+			data.omitUpdate = true
+		}
+	}
+
+	return data
 }
 
 type tagData struct {
@@ -103,4 +167,5 @@ type tagData struct {
 	omit       bool
 	omitInsert bool
 	omitUpdate bool
+	operator   string // The operator used when the column is used in a filter condition
 }
@@ -2,6 +2,7 @@ package parsers
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -199,6 +200,69 @@ func TestColumnTagParser(t *testing.T) {
 	}
 }
 
+func TestParseFilterTag(t *testing.T) {
+	type wants struct {
+		cols []string
+		ops  []string
+		vals []any
+	}
+
+	type innerTestStruct struct {
+		MaxPrice float64 `jagsqlb:"price;op=<="`
+	}
+
+	tests := []struct {
+		name      string
+		input     any
+		wants     wants
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			input: struct {
+				Name     string          `jagsqlb:"name;op=ILIKE"`
+				Status   *string         `jagsqlb:"status"`
+				MinPrice float64         `jagsqlb:"price;op= >= "`
+				Ignored  int             `jagsqlb:"ignored;omit"`
+				Created  int             `jagsqlb:"created;omit-insert"`
+				Inner    innerTestStruct `jagsqlb:";inline"`
+			}{Name: "a%", MinPrice: 10, Created: 3, Inner: innerTestStruct{MaxPrice: 20}},
+			wants: wants{
+				cols: []string{"name", "status", "price", "created", "price"},
+				ops:  []string{"ILIKE", "", ">=", "", "<="},
+				vals: []any{"a%", (*string)(nil), 10.0, 3, 20.0},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Nil QueryMarshaler",
+			input: struct {
+				TestData *testMarshalStruct `jagsqlb:"testData"`
+			}{},
+			wants: wants{
+				cols: []string{"testData"},
+				ops:  []string{""},
+				vals: []any{(*testMarshalStruct)(nil)},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:      "Error; Incorrect Parameter Type",
+			input:     map[string]any{},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCols, gotOps, gotVals, err := ParseFilterTag(tt.input)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wants.cols, gotCols)
+			assert.Equal(t, tt.wants.ops, gotOps)
+			assert.Equal(t, tt.wants.vals, gotVals)
+		})
+	}
+}
+
 type testMarshalStruct struct {
 	Data     string
 	MoreData string
@@ -210,3 +274,60 @@ func (tms testMarshalStruct) MarshalQuery() (string, error) {
 	}
 	return fmt.Sprintf("%s/%s", tms.Data, tms.MoreData), nil
 }
+
+func TestParseColumnFields(t *testing.T) {
+	type nameData struct {
+		GivenName  string `jagsqlb:"given_name"`
+		FamilyName string `jagsqlb:"family_name"`
+	}
+
+	tests := []struct {
+		name       string
+		structType reflect.Type
+		want       map[string][]int
+		assertion  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success; Tags and Field Names",
+			structType: reflect.TypeFor[struct {
+				ID       int `jagsqlb:";omit"`
+				Name     string
+				Email    *string `jagsqlb:"email;omit-update"`
+				internal bool
+			}](),
+			want: map[string][]int{
+				"ID":    {0},
+				"Name":  {1},
+				"email": {2},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Inline Struct",
+			structType: reflect.TypeFor[struct {
+				ID   int       `jagsqlb:"id"`
+				Name nameData  `jagsqlb:";inline"`
+				DOB  time.Time `jagsqlb:"dob"`
+			}](),
+			want: map[string][]int{
+				"id":          {0},
+				"given_name":  {1, 0},
+				"family_name": {1, 1},
+				"dob":         {2},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name:       "Error; Not a Struct",
+			structType: reflect.TypeFor[string](),
+			assertion:  assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseColumnFields(tt.structType)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,22 +1,28 @@
 package inutilities
 
 import (
-	"fmt"
 	"strings"
 
+	"github.com/williabk198/jagsqlb/dialect"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
 // CoalesceSelectColumnsFullString takes in a slice of SelectColumns and returns them as a comma separated string
-// using its fully-qualified definition
-func CoalesceSelectColumnsFullString(cols []intypes.SelectColumn) string {
+// using its fully-qualified definition, along with the parameters of any expressions within them
+func CoalesceSelectColumnsFullString(d dialect.Dialect, cols []intypes.SelectColumn) (string, []any, error) {
 	if len(cols) == 0 {
-		return ""
+		return "", nil, nil
 	}
 
+	var params []any
 	strSlice := make([]string, len(cols))
 	for i, col := range cols {
-		strSlice[i] = col.String()
+		colStr, colParams, err := col.Render(d)
+		if err != nil {
+			return "", nil, err
+		}
+		strSlice[i] = colStr
+		params = append(params, colParams...)
 	}
 
 	result := strings.Join(strSlice, ", ")
@@ -24,24 +30,33 @@ func CoalesceSelectColumnsFullString(cols []intypes.SelectColumn) string {
 		result += " "
 	}
 
-	return result
+	return result, params, nil
 }
 
 // CoalesceSelectColumnNamesString takes in a slice of SelectColumns and returns them as a comma separated string
-// using only the name of the column, and its alias (if one was provided).
-func CoalesceSelectColumnNamesString(cols []intypes.SelectColumn) string {
+// using only the name of the column, and its alias (if one was provided). Expressions are rendered in full and their
+// parameters are returned.
+func CoalesceSelectColumnNamesString(d dialect.Dialect, cols []intypes.SelectColumn) (string, []any, error) {
 	if len(cols) == 0 {
-		return ""
+		return "", nil, nil
 	}
 
+	var params []any
 	strSlice := make([]string, len(cols))
 	for i, col := range cols {
-		if col.Name == "*" {
+		if col.Expression != nil {
+			colStr, colParams, err := col.Render(d)
+			if err != nil {
+				return "", nil, err
+			}
+			strSlice[i] = colStr
+			params = append(params, colParams...)
+		} else if col.Name == "*" {
 			strSlice[i] = col.Name
 		} else if col.Alias == "" {
-			strSlice[i] = fmt.Sprintf("%q", col.Name)
+			strSlice[i] = d.QuoteIdentifier(col.Name)
 		} else {
-			strSlice[i] = fmt.Sprintf("%q AS %q", col.Name, col.Alias)
+			strSlice[i] = d.QuoteIdentifier(col.Name) + " AS " + d.QuoteIdentifier(col.Alias)
 		}
 	}
 
@@ -50,19 +65,26 @@ func CoalesceSelectColumnNamesString(cols []intypes.SelectColumn) string {
 		result += " "
 	}
 
-	return result
+	return result, params, nil
 }
 
-// CoalesceTablesString takes in a slice of Tables and returns them as a comma separated string using its fully qualified definition
-func CoalesceTablesString(tables []intypes.Table) string {
+// CoalesceTablesString takes in a slice of Tables and returns them as a comma separated string using its fully qualified definition,
+// along with the parameters of any subqueries
+func CoalesceTablesString(d dialect.Dialect, tables []intypes.Table) (string, []any, error) {
 	if len(tables) == 0 {
-		return ""
+		return "", nil, nil
 	}
 
 	result := make([]string, len(tables))
+	var params []any
 	for i, table := range tables {
-		result[i] = table.String()
+		tableStr, tableParams, err := table.Parameterize(d)
+		if err != nil {
+			return "", nil, err
+		}
+		result[i] = tableStr
+		params = append(params, tableParams...)
 	}
 
-	return strings.Join(result, ", ")
+	return strings.Join(result, ", "), params, nil
 }
@@ -4,21 +4,26 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
 	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
 func TestCoalesceSelectColumnsFullString(t *testing.T) {
 	type args struct {
+		d    dialect.Dialect
 		cols []intypes.SelectColumn
 	}
 	tests := []struct {
-		name string
-		args args
-		want string
+		name       string
+		args       args
+		want       string
+		wantParams []any
 	}{
 		{
 			name: "Success",
 			args: args{
+				d: dialect.Postgres,
 				cols: []intypes.SelectColumn{
 					{
 						Alias: "t1c1",
@@ -58,26 +63,52 @@ func TestCoalesceSelectColumnsFullString(t *testing.T) {
 			},
 			want: `"table1"."column1" AS "t1c1", "metadata"."table1"."column1", "mt2"."column2", "table2".* `,
 		},
+		{
+			name: "Success; Expressions",
+			args: args{
+				d: dialect.Postgres,
+				cols: []intypes.SelectColumn{
+					{
+						Column: intypes.Column{
+							Name:  "column1",
+							Table: &intypes.Table{Name: "table1"},
+						},
+					},
+					{
+						Alias:      "total",
+						Expression: inexpr.Function{Name: "COALESCE", Args: []any{"t1.price", 0}},
+					},
+				},
+			},
+			want:       `"table1"."column1", COALESCE("t1"."price", ?) AS "total" `,
+			wantParams: []any{0},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, CoalesceSelectColumnsFullString(tt.args.cols))
+			got, gotParams, err := CoalesceSelectColumnsFullString(tt.args.d, tt.args.cols)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantParams, gotParams)
 		})
 	}
 }
 
 func TestCoalesceSelectColumnNamesString(t *testing.T) {
 	type args struct {
+		d    dialect.Dialect
 		cols []intypes.SelectColumn
 	}
 	tests := []struct {
-		name string
-		args args
-		want string
+		name       string
+		args       args
+		want       string
+		wantParams []any
 	}{
 		{
 			name: "Success",
 			args: args{
+				d: dialect.Postgres,
 				cols: []intypes.SelectColumn{
 					{
 						Alias: "t1c1",
@@ -112,26 +143,80 @@ func TestCoalesceSelectColumnNamesString(t *testing.T) {
 			},
 			want: `"column1" AS "t1c1", "column2", "column3" `,
 		},
+		{
+			name: "Success; MySQL",
+			args: args{
+				d: dialect.MySQL,
+				cols: []intypes.SelectColumn{
+					{
+						Alias:  "t1c1",
+						Column: intypes.Column{Name: "column1"},
+					},
+					{
+						Column: intypes.Column{Name: "*"},
+					},
+				},
+			},
+			want: "`column1` AS `t1c1`, * ",
+		},
+		{
+			name: "Success; Expression",
+			args: args{
+				d: dialect.MySQL,
+				cols: []intypes.SelectColumn{
+					{
+						Alias:      "total",
+						Expression: inexpr.Function{Name: "SUM", Args: []any{"t1.price"}},
+					},
+				},
+			},
+			want: "SUM(`t1`.`price`) AS `total` ",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, CoalesceSelectColumnNamesString(tt.args.cols))
+			got, gotParams, err := CoalesceSelectColumnNamesString(tt.args.d, tt.args.cols)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantParams, gotParams)
 		})
 	}
 }
 
 func TestCoalesceTablesString(t *testing.T) {
 	type args struct {
+		d      dialect.Dialect
 		tables []intypes.Table
 	}
 	tests := []struct {
-		name string
-		args args
-		want string
+		name       string
+		args       args
+		want       string
+		wantParams []any
+		assertion  assert.ErrorAssertionFunc
 	}{
 		{
 			name: "Success",
 			args: args{
+				d: dialect.Postgres,
+				tables: []intypes.Table{
+					{
+						Name:   "table1",
+						Schema: "schema",
+					},
+					{
+						Alias: "t2",
+						Name:  "table2",
+					},
+				},
+			},
+			want:      `"schema"."table1", "table2" AS "t2"`,
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; SQL Server",
+			args: args{
+				d: dialect.SQLServer,
 				tables: []intypes.Table{
 					{
 						Name:   "table1",
@@ -143,12 +228,47 @@ func TestCoalesceTablesString(t *testing.T) {
 					},
 				},
 			},
-			want: `"schema"."table1", "table2" AS "t2"`,
+			want:      `[schema].[table1], [table2] AS [t2]`,
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Subquery",
+			args: args{
+				d: dialect.Postgres,
+				tables: []intypes.Table{
+					{
+						Name: "table1",
+					},
+					{
+						Alias:    "s",
+						SubQuery: inexpr.Function{Name: "generate_series", Args: []any{1, 10}},
+						Lateral:  true,
+					},
+				},
+			},
+			want:       `"table1", LATERAL generate_series(?, ?) AS "s"`,
+			wantParams: []any{1, 10},
+			assertion:  assert.NoError,
+		},
+		{
+			name: "Error; Subquery Without Alias",
+			args: args{
+				d: dialect.Postgres,
+				tables: []intypes.Table{
+					{
+						SubQuery: inexpr.Function{Name: "generate_series", Args: []any{1, 10}},
+					},
+				},
+			},
+			assertion: assert.Error,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, CoalesceTablesString(tt.args.tables))
+			got, gotParams, err := CoalesceTablesString(tt.args.d, tt.args.tables)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantParams, gotParams)
 		})
 	}
 }
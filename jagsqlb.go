@@ -2,6 +2,7 @@ package jagsqlb
 
 import (
 	"github.com/williabk198/jagsqlb/builders"
+	"github.com/williabk198/jagsqlb/dialect"
 	inbuilders "github.com/williabk198/jagsqlb/internal/builders"
 )
 
@@ -9,29 +10,69 @@ import (
 type SqlBuilder interface {
 	Delete(table string) builders.DeleteBuilder
 	Insert(table string) builders.InsertBuilder
-	Select(table string, columns ...string) builders.SelectBuilder
+	// Select creates a "SELECT" statement. The table can either be a string, such as "schema.table AS t",
+	// or a subquery created with `SubQuery`.
+	Select(table any, columns ...any) builders.SelectBuilder
 	Update(table string) builders.UpdateBuilder
+
+	// With starts a statement that is preceded by a "WITH" clause containing the provided common table expression.
+	// The name of the common table expression can then be used like any other table.
+	With(name string, query builders.Builder, columns ...string) builders.WithBuilder
+	// WithRecursive starts a statement that is preceded by a "WITH RECURSIVE" clause containing the provided common table expression
+	WithRecursive(name string, query builders.Builder, columns ...string) builders.WithBuilder
 }
 
-type sqlBuilder struct{}
+// Option configures the SqlBuilder returned by NewSqlBuilder
+type Option func(*sqlBuilder)
+
+// WithDialect sets the SQL dialect that every query built by the SqlBuilder will be rendered with.
+// By default, queries are rendered for PostgreSQL.
+//
+// For example:
+//
+//	sqlBuilder := jagsqlb.NewSqlBuilder(jagsqlb.WithDialect(dialect.MySQL))
+func WithDialect(d dialect.Dialect) Option {
+	return func(sb *sqlBuilder) {
+		sb.dialect = d
+	}
+}
+
+type sqlBuilder struct {
+	dialect dialect.Dialect
+}
 
 func (sb sqlBuilder) Delete(table string) builders.DeleteBuilder {
-	return inbuilders.NewDeleteBuilder(table)
+	return inbuilders.NewDeleteBuilder(sb.dialect, table)
 }
 
 func (sb sqlBuilder) Insert(table string) builders.InsertBuilder {
-	return inbuilders.NewInsertBuilder(table)
+	return inbuilders.NewInsertBuilder(sb.dialect, table)
 }
 
-func (sb sqlBuilder) Select(table string, columns ...string) builders.SelectBuilder {
-	return inbuilders.NewSelectBuilder(table, columns...)
+func (sb sqlBuilder) Select(table any, columns ...any) builders.SelectBuilder {
+	return inbuilders.NewSelectBuilder(sb.dialect, table, columns...)
 }
 
 func (sb sqlBuilder) Update(table string) builders.UpdateBuilder {
-	return inbuilders.NewUpdateBuilder(table)
+	return inbuilders.NewUpdateBuilder(sb.dialect, table)
 }
 
-// NewSelectBuilder creates and returns a reusable SQL Builder
-func NewSqlBuilder() SqlBuilder {
-	return sqlBuilder{}
+func (sb sqlBuilder) With(name string, query builders.Builder, columns ...string) builders.WithBuilder {
+	return inbuilders.NewWithBuilder(sb.dialect).With(name, query, columns...)
+}
+
+func (sb sqlBuilder) WithRecursive(name string, query builders.Builder, columns ...string) builders.WithBuilder {
+	return inbuilders.NewWithBuilder(sb.dialect).WithRecursive(name, query, columns...)
+}
+
+// NewSqlBuilder creates and returns a reusable SQL Builder
+func NewSqlBuilder(opts ...Option) SqlBuilder {
+	sb := sqlBuilder{
+		dialect: dialect.Postgres,
+	}
+	for _, opt := range opts {
+		opt(&sb)
+	}
+
+	return sb
 }
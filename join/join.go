@@ -7,10 +7,22 @@ import (
 
 const (
 	TypeInner injoin.Type = "INNER JOIN"
-	TypeOuter injoin.Type = "OUTER JOIN"
+	// Deprecated: "OUTER JOIN" is not valid on its own, so this is now the same as TypeFull. Use TypeFull instead.
+	TypeOuter injoin.Type = "FULL OUTER JOIN"
 	TypeLeft  injoin.Type = "LEFT JOIN"
 	TypeRight injoin.Type = "RIGHT JOIN"
+	// TypeFull keeps every row from both tables, whether or not they have a matching row. This is not supported by MySQL.
+	TypeFull injoin.Type = "FULL OUTER JOIN"
+	// TypeCross joins every row of one table to every row of the other table. It doesn't have a relation, so `None` must be used.
 	TypeCross injoin.Type = "CROSS JOIN"
+
+	// The NATURAL joins join the tables using every column that has the same name in both tables. They don't have a relation,
+	// so `None` must be used. These are not supported by SQL Server.
+
+	TypeNatural      injoin.Type = "NATURAL JOIN"
+	TypeNaturalLeft  injoin.Type = "NATURAL LEFT JOIN"
+	TypeNaturalRight injoin.Type = "NATURAL RIGHT JOIN"
+	TypeNaturalFull  injoin.Type = "NATURAL FULL OUTER JOIN"
 )
 
 // On represent the "ON" portion of a "JOIN" clause and defines how the table will be joined based on the provided conditions.
@@ -22,11 +34,24 @@ func On(condition incondition.Condition, additionalConds ...incondition.Conditio
 	}
 }
 
-// Using represents the "USING" part of a "JOIN" clause. If the tables being joined both have the provided column name,
-// then it will be joined using that column name.
-func Using(columnName string) injoin.Relation {
+// Using represents the "USING" part of a "JOIN" clause. If the tables being joined both have the provided column names,
+// then they will be joined using those column names. e.g. `USING ("col1", "col2")`
+func Using(columnName string, moreColumnNames ...string) injoin.Relation {
 	return injoin.Relation{
 		Keyword:  "USING",
-		Relation: columnName,
+		Relation: append([]string{columnName}, moreColumnNames...),
 	}
 }
+
+// None is used in place of a relation for the join types that don't have one, such as TypeCross and TypeNatural.
+// It can also be used to join a subquery created with `jagsqlb.LateralSubQuery`, since its conditions are usually a part of
+// the subquery. In which case, it is joined "ON TRUE" if the join type needs a relation.
+//
+// For example:
+//
+//	sqlBuilder.Select("sizes", "name").Join(join.TypeCross, "colors", join.None(), "name")
+//
+// Will result in `SELECT "sizes"."name", "colors"."name" FROM "sizes" CROSS JOIN "colors";`
+func None() injoin.Relation {
+	return injoin.Relation{}
+}
@@ -54,7 +54,8 @@ func TestOn(t *testing.T) {
 
 func TestUsing(t *testing.T) {
 	type args struct {
-		columnName string
+		columnName      string
+		moreColumnNames []string
 	}
 	tests := []struct {
 		name string
@@ -68,13 +69,28 @@ func TestUsing(t *testing.T) {
 			},
 			want: injoin.Relation{
 				Keyword:  "USING",
-				Relation: "col1",
+				Relation: []string{"col1"},
+			},
+		},
+		{
+			name: "Success; Multiple Columns",
+			args: args{
+				columnName:      "col1",
+				moreColumnNames: []string{"col2", "col3"},
+			},
+			want: injoin.Relation{
+				Keyword:  "USING",
+				Relation: []string{"col1", "col2", "col3"},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, Using(tt.args.columnName))
+			assert.Equal(t, tt.want, Using(tt.args.columnName, tt.args.moreColumnNames...))
 		})
 	}
 }
+
+func TestNone(t *testing.T) {
+	assert.Equal(t, injoin.Relation{}, None())
+}
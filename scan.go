@@ -0,0 +1,40 @@
+package jagsqlb
+
+import (
+	"database/sql"
+
+	inscan "github.com/williabk198/jagsqlb/internal/scan"
+)
+
+// ScanAll reads every row of `rows` into a value of type T, and closes `rows` once finished.
+//
+// If T is a struct, or a pointer to one, then each column is read into the field that it is associated with by the
+// `jagsqlb` struct tag, following the same rules as the Insert and Update Builders. Fields of inlined structs are
+// included, while the "omit" options are ignored. Pointer fields are set to nil when the column is NULL, and any field
+// implementing `sql.Scanner` will be used to read its column. An error is returned if a column isn't associated
+// with any field.
+//
+// Any other type, including structs that implement `sql.Scanner`, is read into directly. This requires the result set
+// to have exactly one column.
+//
+// For example:
+//
+//	type Person struct {
+//	    ID   int    `jagsqlb:"id"`
+//	    Name string `jagsqlb:"full_name"`
+//	}
+//
+//	rows, err := db.QueryContext(ctx, query, params...)
+//	if err != nil {
+//	    return err
+//	}
+//	people, err := jagsqlb.ScanAll[Person](rows)
+func ScanAll[T any](rows *sql.Rows) ([]T, error) {
+	return inscan.All[T](rows)
+}
+
+// ScanOne reads the first row of `rows` into a value of type T, following the same rules as `ScanAll`, and closes
+// `rows` once finished. If there are no rows, then `sql.ErrNoRows` is returned.
+func ScanOne[T any](rows *sql.Rows) (T, error) {
+	return inscan.One[T](rows)
+}
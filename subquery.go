@@ -0,0 +1,48 @@
+package jagsqlb
+
+import (
+	"github.com/williabk198/jagsqlb/builders"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
+)
+
+// SubQuery uses the result set of `query` as a table that can be selected from or joined, which is also known as a derived table.
+// The alias is required, and is used to refer to the columns of the subquery. Any parameters of the subquery are
+// numbered along with the rest of the query, in the order that they appear.
+//
+// For example:
+//
+//	totals := sqlBuilder.Select("orders", "customer_id", expr.Sum("total").As("spent")).GroupBy("customer_id")
+//	sqlBuilder.Select("customers AS c", "name").Join(
+//	  join.TypeLeft, jagsqlb.SubQuery(totals, "t"), join.On(condition.Equals("t.customer_id", condition.ColumnValue("c.id"))), "spent",
+//	)
+//
+// Will result in:
+//
+//	SELECT "c"."name", "t"."spent" FROM "customers" AS "c" LEFT JOIN (SELECT "customer_id", SUM("total") AS "spent"
+//	FROM "orders" GROUP BY "customer_id") AS "t" ON "t"."customer_id" = "c"."id";
+func SubQuery(query builders.Builder, alias string) intypes.Table {
+	return intypes.Table{
+		Alias:    alias,
+		SubQuery: inexpr.Subquery{Builder: query},
+	}
+}
+
+// LateralSubQuery is the same as SubQuery, but the subquery is preceded by "LATERAL" so that it can refer to the columns
+// of the tables that come before it. This is not supported by SQLite or SQL Server.
+//
+// For example:
+//
+//	latest := sqlBuilder.Select("orders", "total").Where(condition.Equals("customer_id", condition.ColumnValue("c.id"))).
+//	  OrderBy(types.ColumnOrdering{ColumnName: "created_at", Ordering: types.OrderingDescending}).Limit(1)
+//	sqlBuilder.Select("customers AS c", "name").Table(jagsqlb.LateralSubQuery(latest, "l"), "total")
+//
+// Will result in:
+//
+//	SELECT "c"."name", "l"."total" FROM "customers" AS "c", LATERAL (SELECT "total" FROM "orders"
+//	WHERE "customer_id" = "c"."id" ORDER BY "created_at" DESC LIMIT 1) AS "l";
+func LateralSubQuery(query builders.Builder, alias string) intypes.Table {
+	table := SubQuery(query, alias)
+	table.Lateral = true
+	return table
+}
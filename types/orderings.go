@@ -2,7 +2,12 @@ package types
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
 
+	"github.com/williabk198/jagsqlb/dialect"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
 	"github.com/williabk198/jagsqlb/internal/utilities/parsers"
 )
 
@@ -13,20 +18,197 @@ const (
 	OrderingDescending ordering = "DESC"
 )
 
+type nullsOrdering string
+
+const (
+	// NullsFirst places NULL values before every other value, regardless of the direction of the ordering
+	NullsFirst nullsOrdering = "NULLS FIRST"
+	// NullsLast places NULL values after every other value, regardless of the direction of the ordering
+	NullsLast nullsOrdering = "NULLS LAST"
+)
+
 var (
 	columnParser = parsers.NewColumnParser()
+
+	// collationPattern matches the collation names that are safe to render without quoting them
+	collationPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
 )
 
+// ColumnOrdering defines how a result set, or window, is sorted by a single column or expression.
+// Only one of Expression, Position or ColumnName is used, in that order of precedence. ColumnName can also refer to the alias
+// of a column in the "SELECT" clause.
 type ColumnOrdering struct {
 	ColumnName string
 	Ordering   ordering
+
+	// Expression is ordered by in place of ColumnName when it is not nil
+	Expression intypes.Expression
+
+	// Position orders by the column at that position in the "SELECT" clause, starting at 1, when it is greater than 0.
+	// This can only be used in the "ORDER BY" clause of a query and can't be used with `SeekAfter`.
+	Position uint
+
+	// Nulls determines where NULL values are placed. When it is empty, the default placement of the database is used.
+	// If the dialect doesn't support "NULLS FIRST" or "NULLS LAST", then the placement is emulated by sorting on whether
+	// the value is NULL first. e.g. `CASE WHEN "col" IS NULL THEN 0 ELSE 1 END, "col" ASC`
+	Nulls nullsOrdering
+
+	// Collation is the name of the collation used to compare the values, such as "C" or "utf8mb4_bin"
+	Collation string
+
+	err error
+}
+
+// Asc returns an ordering that sorts by `column` in ascending order. The column can either be a string, which is either
+// a column name or alias, a positive integer for the position of the column in the "SELECT" clause,
+// or an expression from the `expr` package.
+//
+// For example:
+//
+//	types.Asc(expr.Lower("name")).NullsLast()
+//
+// Will result in `LOWER("name") ASC NULLS LAST`.
+func Asc(column any) ColumnOrdering {
+	return newColumnOrdering(column, OrderingAscending)
+}
+
+// Desc returns an ordering that sorts by `column` in descending order. It accepts the same columns as Asc.
+func Desc(column any) ColumnOrdering {
+	return newColumnOrdering(column, OrderingDescending)
+}
+
+func newColumnOrdering(column any, o ordering) ColumnOrdering {
+	co := ColumnOrdering{Ordering: o}
+	switch c := column.(type) {
+	case string:
+		co.ColumnName = c
+	case intypes.Ordering:
+		co.err = intypes.ErrMisplacedOrdering
+	case intypes.Expression:
+		co.Expression = c
+	case int:
+		if c < 1 {
+			co.err = fmt.Errorf("ordering positions start at 1, but received %d", c)
+			break
+		}
+		co.Position = uint(c)
+	case uint:
+		if c == 0 {
+			co.err = fmt.Errorf("ordering positions start at 1, but received %d", c)
+			break
+		}
+		co.Position = c
+	default:
+		co.err = fmt.Errorf("invalid ordering column type(%T)", column)
+	}
+	return co
+}
+
+// NullsFirst returns a copy of the ordering that places NULL values before every other value
+func (co ColumnOrdering) NullsFirst() ColumnOrdering {
+	co.Nulls = NullsFirst
+	return co
+}
+
+// NullsLast returns a copy of the ordering that places NULL values after every other value
+func (co ColumnOrdering) NullsLast() ColumnOrdering {
+	co.Nulls = NullsLast
+	return co
+}
+
+// Collate returns a copy of the ordering that compares values using the provided collation
+func (co ColumnOrdering) Collate(collation string) ColumnOrdering {
+	co.Collation = collation
+	return co
 }
 
+// Stringify returns the ordering as it would appear in an "ORDER BY" clause for PostgreSQL.
+//
+// Deprecated: Use Parameterize instead, which quotes the column for the provided dialect and returns the parameters of
+// any expression being ordered by. An error is returned if the ordering has any parameters, since they would be lost.
 func (co ColumnOrdering) Stringify() (string, error) {
-	column, err := columnParser.Parse(co.ColumnName)
+	query, params, err := co.Parameterize(dialect.Postgres)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse column name %q: %w", co.ColumnName, err)
+		return "", err
+	}
+	if len(params) > 0 {
+		return "", fmt.Errorf("the ordering has %d parameter(s), which can only be returned by Parameterize", len(params))
+	}
+	return query, nil
+}
+
+// Parameterize returns the ordering as it would appear in an "ORDER BY" clause, quoting the column using the provided dialect.
+// If the ordering uses an expression, then its parameters are returned as well.
+func (co ColumnOrdering) Parameterize(d dialect.Dialect) (string, []any, error) {
+	columnStr, params, err := co.ParameterizeColumn(d)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := fmt.Sprintf("%s %s", columnStr, co.Ordering)
+	if co.Nulls == "" {
+		return query, params, nil
+	}
+
+	if d.Supports(dialect.ClauseNullsOrdering) {
+		return query + " " + string(co.Nulls), params, nil
+	}
+
+	// A position within the "CASE" expression would be treated as a constant rather than a column
+	if co.Position > 0 {
+		return "", nil, fmt.Errorf(
+			"can't emulate %q for a positional ordering: %w", co.Nulls, intypes.NewUnsupportedClauseError(d, dialect.ClauseNullsOrdering),
+		)
 	}
 
-	return fmt.Sprintf("%s %s", column.String(), co.Ordering), nil
+	nullRank, valueRank := 0, 1
+	if co.Nulls == NullsLast {
+		nullRank, valueRank = 1, 0
+	}
+	return fmt.Sprintf("CASE WHEN %s IS NULL THEN %d ELSE %d END, %s", columnStr, nullRank, valueRank, query),
+		append(slices.Clone(params), params...), nil
+}
+
+// ParameterizeColumn returns the column, or expression, that is being ordered by without the direction of the ordering
+func (co ColumnOrdering) ParameterizeColumn(d dialect.Dialect) (string, []any, error) {
+	if co.err != nil {
+		return "", nil, co.err
+	}
+
+	var columnStr string
+	var params []any
+	switch {
+	case co.Expression != nil:
+		if _, ok := co.Expression.(intypes.Ordering); ok {
+			return "", nil, fmt.Errorf("invalid ordering expression: %w", intypes.ErrMisplacedOrdering)
+		}
+		exprStr, exprParams, err := co.Expression.Parameterize(d)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parameterize ordering expression: %w", err)
+		}
+		columnStr, params = exprStr, exprParams
+	case co.Position > 0:
+		if co.Collation != "" {
+			return "", nil, fmt.Errorf("a collation can't be applied to the ordering of position %d", co.Position)
+		}
+		return strconv.FormatUint(uint64(co.Position), 10), nil, nil
+	default:
+		column, err := columnParser.Parse(co.ColumnName)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parse column name %q: %w", co.ColumnName, err)
+		}
+		columnStr = column.Render(d)
+	}
+
+	if co.Collation == "" {
+		return columnStr, params, nil
+	}
+
+	if d.Supports(dialect.ClauseQuotedCollation) {
+		return columnStr + " COLLATE " + d.QuoteIdentifier(co.Collation), params, nil
+	}
+	if !collationPattern.MatchString(co.Collation) {
+		return "", nil, fmt.Errorf("invalid collation name %q", co.Collation)
+	}
+	return columnStr + " COLLATE " + co.Collation, params, nil
 }
@@ -4,14 +4,19 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/williabk198/jagsqlb/dialect"
+	inexpr "github.com/williabk198/jagsqlb/internal/expr"
+	intypes "github.com/williabk198/jagsqlb/internal/types"
 )
 
-func TestColumnOrdering_Stringify(t *testing.T) {
+func TestColumnOrdering_Parameterize(t *testing.T) {
 	tests := []struct {
-		name      string
-		co        ColumnOrdering
-		want      string
-		assertion assert.ErrorAssertionFunc
+		name       string
+		co         ColumnOrdering
+		d          dialect.Dialect
+		want       string
+		wantParams []any
+		assertion  assert.ErrorAssertionFunc
 	}{
 		{
 			name: "Success",
@@ -22,6 +27,114 @@ func TestColumnOrdering_Stringify(t *testing.T) {
 			want:      `"col1" ASC`,
 			assertion: assert.NoError,
 		},
+		{
+			name: "Success; Expression",
+			co: ColumnOrdering{
+				Expression: inexpr.Function{Name: "COALESCE", Args: []any{"col1", 0}},
+				Ordering:   OrderingDescending,
+			},
+			want:       `COALESCE("col1", ?) DESC`,
+			wantParams: []any{0},
+			assertion:  assert.NoError,
+		},
+		{
+			name: "Success; Nulls Last",
+			co: ColumnOrdering{
+				ColumnName: "col1",
+				Ordering:   OrderingAscending,
+				Nulls:      NullsLast,
+			},
+			want:      `"col1" ASC NULLS LAST`,
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Nulls First Emulated",
+			co: ColumnOrdering{
+				ColumnName: "col1",
+				Ordering:   OrderingDescending,
+				Nulls:      NullsFirst,
+			},
+			d:         dialect.MySQL,
+			want:      "CASE WHEN `col1` IS NULL THEN 0 ELSE 1 END, `col1` DESC",
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Nulls Last Emulated w/ Expression",
+			co: ColumnOrdering{
+				Expression: inexpr.Function{Name: "COALESCE", Args: []any{"col1", 0}},
+				Ordering:   OrderingAscending,
+				Nulls:      NullsLast,
+			},
+			d:          dialect.SQLServer,
+			want:       `CASE WHEN COALESCE([col1], ?) IS NULL THEN 1 ELSE 0 END, COALESCE([col1], ?) ASC`,
+			wantParams: []any{0, 0},
+			assertion:  assert.NoError,
+		},
+		{
+			name: "Success; Position",
+			co: ColumnOrdering{
+				Position: 2,
+				Ordering: OrderingDescending,
+				Nulls:    NullsLast,
+			},
+			want:      `2 DESC NULLS LAST`,
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Collation",
+			co: ColumnOrdering{
+				ColumnName: "t.name",
+				Ordering:   OrderingAscending,
+				Collation:  "C",
+			},
+			want:      `"t"."name" COLLATE "C" ASC`,
+			assertion: assert.NoError,
+		},
+		{
+			name: "Success; Unquoted Collation",
+			co: ColumnOrdering{
+				ColumnName: "name",
+				Ordering:   OrderingAscending,
+				Collation:  "Latin1_General_CS_AS",
+			},
+			d:         dialect.SQLServer,
+			want:      `[name] COLLATE Latin1_General_CS_AS ASC`,
+			assertion: assert.NoError,
+		},
+		{
+			name: "Error; Invalid Unquoted Collation",
+			co: ColumnOrdering{
+				ColumnName: "name",
+				Ordering:   OrderingAscending,
+				Collation:  "Latin1 General; DROP",
+			},
+			d:         dialect.SQLServer,
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Position w/ Collation",
+			co: ColumnOrdering{
+				Position:  1,
+				Ordering:  OrderingAscending,
+				Collation: "C",
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "Error; Position w/ Emulated Nulls",
+			co: ColumnOrdering{
+				Position: 1,
+				Ordering: OrderingAscending,
+				Nulls:    NullsFirst,
+			},
+			d:         dialect.MySQL,
+			assertion: assert.Error,
+		},
+		{
+			name:      "Error; Invalid Column Type",
+			co:        Asc(1.5),
+			assertion: assert.Error,
+		},
 		{
 			name: "Error",
 			co: ColumnOrdering{
@@ -33,9 +146,75 @@ func TestColumnOrdering_Stringify(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.co.Stringify()
+			d := tt.d
+			if d == nil {
+				d = dialect.Postgres
+			}
+			got, gotParams, err := tt.co.Parameterize(d)
 			tt.assertion(t, err)
 			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
+
+func TestOrderingHelpers(t *testing.T) {
+	lower := inexpr.Function{Name: "LOWER", Args: []any{"name"}}
+	tests := []struct {
+		name string
+		got  ColumnOrdering
+		want ColumnOrdering
+	}{
+		{
+			name: "Asc Column",
+			got:  Asc("name"),
+			want: ColumnOrdering{ColumnName: "name", Ordering: OrderingAscending},
+		},
+		{
+			name: "Desc Expression",
+			got:  Desc(lower),
+			want: ColumnOrdering{Expression: lower, Ordering: OrderingDescending},
+		},
+		{
+			name: "Asc Position",
+			got:  Asc(2),
+			want: ColumnOrdering{Position: 2, Ordering: OrderingAscending},
+		},
+		{
+			name: "Nulls First",
+			got:  Desc("name").NullsFirst(),
+			want: ColumnOrdering{ColumnName: "name", Ordering: OrderingDescending, Nulls: NullsFirst},
+		},
+		{
+			name: "Nulls Last w/ Collation",
+			got:  Asc("name").Collate("C").NullsLast(),
+			want: ColumnOrdering{ColumnName: "name", Ordering: OrderingAscending, Nulls: NullsLast, Collation: "C"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
 		})
 	}
+
+	_, _, err := Asc(0).Parameterize(dialect.Postgres)
+	assert.Error(t, err)
+}
+
+func TestColumnOrdering_Stringify(t *testing.T) {
+	got, err := Desc("t1.col1").Stringify()
+	assert.NoError(t, err)
+	assert.Equal(t, `"t1"."col1" DESC`, got)
+
+	got, err = Asc(inexpr.Function{Name: "COALESCE", Args: []any{"col1", 0}}).Stringify()
+	assert.Error(t, err)
+	assert.Empty(t, got)
+}
+
+func TestColumnOrdering_NestedOrdering(t *testing.T) {
+	_, _, err := Asc(Desc("col1")).Parameterize(dialect.Postgres)
+	assert.ErrorIs(t, err, intypes.ErrMisplacedOrdering)
+
+	_, _, err = ColumnOrdering{Expression: Desc("col1"), Ordering: OrderingAscending}.Parameterize(dialect.Postgres)
+	assert.ErrorIs(t, err, intypes.ErrMisplacedOrdering)
 }